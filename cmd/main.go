@@ -1,17 +1,29 @@
 package main
 
 import (
+	"context"
 	"log/slog"
+	"os"
 
+	"railgorail/avito/docs"
 	"railgorail/avito/internal/config"
+	"railgorail/avito/internal/directory"
 	"railgorail/avito/internal/lib/logger"
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/metrics"
 	"railgorail/avito/internal/repo"
 	"railgorail/avito/internal/server"
+	directoryservice "railgorail/avito/internal/service/directory"
+	"railgorail/avito/internal/service/health"
+	"railgorail/avito/internal/service/idempotency"
 	"railgorail/avito/internal/service/pr"
+	"railgorail/avito/internal/service/reconciler"
 	"railgorail/avito/internal/service/stats"
 	"railgorail/avito/internal/service/team"
 	"railgorail/avito/internal/service/user"
 	"railgorail/avito/internal/storage"
+	"railgorail/avito/internal/tracing"
+	healthhandler "railgorail/avito/internal/transport/http/handlers/health"
 	prhandler "railgorail/avito/internal/transport/http/handlers/pr"
 	statshandler "railgorail/avito/internal/transport/http/handlers/stats"
 	teamhandler "railgorail/avito/internal/transport/http/handlers/team"
@@ -30,35 +42,128 @@ func main() {
 	log := logger.New(cfg.Env)
 	log.Info("starting service", slog.String("env", cfg.Env))
 
+	// tracing
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing)
+	if err != nil {
+		log.Error("failed to init tracing", sl.Err(err))
+		os.Exit(1)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.HTTPServer.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Error("failed to flush traces", sl.Err(err))
+		}
+	}()
+
 	// storage
 	db, cleanup := storage.MustInit(cfg.Postgres.DatabaseURL, log)
 	defer cleanup()
 
+	metrics.RegisterDB(db.DB, "postgres")
+
 	// repo layer
 	trManager := manager.Must(trm.NewDefaultFactory(db))
 
 	teamRepo := repo.NewTeamRepo(db, trm.DefaultCtxGetter)
 	userRepo := repo.NewUserRepo(db, trm.DefaultCtxGetter)
 	prRepo := repo.NewPullRequestRepo(db, trm.DefaultCtxGetter, trManager)
-	statsRepo := repo.NewStatisticsRepo(db)
+	statsRepo := repo.NewStatisticsRepo(db, trm.DefaultCtxGetter)
+	idempotencyRepo := repo.NewIdempotencyRepo(db)
+
+	// service layer, transactions are timed
+	txManager := metrics.NewTransactionManager(trManager)
+
+	// services wake the reconciler when a team gets new candidates
+	reconcilerService := reconciler.NewReconcilerService(log, txManager, prRepo, userRepo)
+	teamService := team.NewTeamService(txManager, teamRepo, userRepo, prRepo, reconcilerService)
+	userService := user.NewUserService(txManager, prRepo, userRepo, teamRepo, reconcilerService)
+	switch cfg.PullRequest.StackMergePolicy {
+	case pr.MergePolicyBlock, pr.MergePolicyAllow:
+	default:
+		log.Error("unknown stack merge policy", slog.String("policy", cfg.PullRequest.StackMergePolicy))
+		cleanup()
+		os.Exit(1)
+	}
+	if cfg.PullRequest.ReconcileInterval <= 0 {
+		log.Error("reconcile interval must be positive", slog.Duration("interval", cfg.PullRequest.ReconcileInterval))
+		cleanup()
+		os.Exit(1)
+	}
+	prService := pr.NewPullRequestService(txManager, prRepo, prRepo, userRepo, teamRepo, cfg.PullRequest.StackMergePolicy)
+	statsService := stats.NewStatsService(txManager, statsRepo)
+
+	latestMigration, err := storage.LatestMigration()
+	if err != nil {
+		log.Error("failed to read migrations", sl.Err(err))
+		cleanup()
+		os.Exit(1)
+	}
+	healthService := health.NewHealthService(log, repo.NewHealthRepo(db), latestMigration, cfg.HTTPServer.ReadyTimeout)
+
+	// background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if cfg.Directory.Provider != "" {
+		var provider directoryservice.DirectoryProvider
+		switch cfg.Directory.Provider {
+		case "ldap":
+			provider = directory.NewLDAPProvider(cfg.Directory.LDAP)
+		case "file":
+			provider = directory.NewFileProvider(cfg.Directory.File)
+		default:
+			log.Error("unknown directory provider", slog.String("provider", cfg.Directory.Provider))
+			cleanup()
+			os.Exit(1)
+		}
+		if cfg.Directory.SyncInterval <= 0 || cfg.Directory.MaxDeactivations < 0 {
+			log.Error("invalid directory sync settings",
+				slog.Duration("interval", cfg.Directory.SyncInterval),
+				slog.Int("max_deactivations", cfg.Directory.MaxDeactivations),
+			)
+			cleanup()
+			os.Exit(1)
+		}
+
+		directoryService := directoryservice.NewDirectoryService(log, provider, teamService,
+			cfg.Directory.GroupMapping, cfg.Directory.MaxDeactivations)
+		go directoryService.Run(ctx, cfg.Directory.SyncInterval)
+	}
+
+	go reconcilerService.Run(ctx, cfg.PullRequest.ReconcileInterval)
 
-	// service layer
-	teamService := team.NewTeamService(trManager, teamRepo, userRepo, prRepo)
-	userService := user.NewUserService(trManager, prRepo, userRepo, teamRepo)
-	prService := pr.NewPullRequestService(trManager, prRepo, prRepo, userRepo)
-	statsService := stats.NewStatsService(trManager, statsRepo)
+	if cfg.HTTPServer.IdempotencyPurgeInterval <= 0 {
+		log.Error("idempotency purge interval must be positive", slog.Duration("interval", cfg.HTTPServer.IdempotencyPurgeInterval))
+		cleanup()
+		os.Exit(1)
+	}
+	idempotencyService := idempotency.NewIdempotencyService(log, idempotencyRepo, cfg.HTTPServer.IdempotencyTTL)
+	go idempotencyService.Run(ctx, cfg.HTTPServer.IdempotencyPurgeInterval)
 
 	// transport layer
 	teamHandler := teamhandler.NewTeamHandler(log, teamService)
 	userHandler := userhandler.NewUserHandler(log, userService)
 	prHandler := prhandler.NewPrHandler(log, prService)
 	statsHandler := statshandler.NewStatsHandler(log, statsService)
+	healthHandler := healthhandler.NewHealthHandler(log, healthService)
 
-	// http router
-	router := router.New(log, cfg, teamHandler, userHandler, prHandler, statsHandler)
+	// http router, requests are validated against the embedded OpenAPI spec
+	spec, err := docs.LoadOpenAPI(ctx)
+	if err != nil {
+		log.Error("failed to load openapi spec", sl.Err(err))
+		cleanup()
+		os.Exit(1)
+	}
+	router, err := router.New(log, cfg, spec, teamHandler, userHandler, prHandler, statsHandler, healthHandler, idempotencyRepo)
+	if err != nil {
+		log.Error("failed to build router", sl.Err(err))
+		cleanup()
+		os.Exit(1)
+	}
 
 	// server
-	srv := server.New(router, log, cfg)
+	srv := server.New(router, log, cfg, healthService.Drain)
 	srv.Run(cfg.HTTPServer.ShutdownTimeout)
 
 	log.Info("service stopped")
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"railgorail/avito/internal/config"
+	"railgorail/avito/internal/lib/logger"
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service/stats"
+	"railgorail/avito/internal/storage"
+
+	trm "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
+)
+
+// statsrebuild refills the daily stats rollups from the reviewer and PR
+// tables. The service may keep running, its writes wait for the rebuild.
+func main() {
+	cfg := config.MustLoad()
+	log := logger.New(cfg.Env)
+
+	db, cleanup := storage.MustInit(cfg.Postgres.DatabaseURL, log)
+	defer cleanup()
+
+	trManager := manager.Must(trm.NewDefaultFactory(db))
+
+	statsRepo := repo.NewStatisticsRepo(db, trm.DefaultCtxGetter)
+	statsService := stats.NewStatsService(trManager, statsRepo)
+
+	if err := statsService.RebuildRollups(context.Background()); err != nil {
+		log.Error("failed to rebuild stats rollups", sl.Err(err))
+		cleanup()
+		os.Exit(1)
+	}
+
+	log.Info("stats rollups rebuilt")
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log/slog"
+	"os"
+
+	"railgorail/avito/internal/config"
+	"railgorail/avito/internal/lib/logger"
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service/team"
+	"railgorail/avito/internal/storage"
+	"railgorail/avito/internal/transport/http/dto"
+
+	trm "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/go-playground/validator/v10"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	file := flag.String("file", "", "path to the roster file (YAML or JSON)")
+	dryRun := flag.Bool("dry-run", false, "print the planned changes without applying them")
+	maxDeactivations := flag.Int("max-deactivations", team.DefaultMaxDeactivations,
+		"refuse a sync that deactivates more users, 0 turns the limit off")
+	flag.Parse()
+
+	cfg := config.MustLoad()
+	log := logger.New(cfg.Env)
+
+	if *maxDeactivations < 0 {
+		log.Error("max-deactivations must not be negative")
+		os.Exit(1)
+	}
+
+	if *file == "" {
+		log.Error("roster file is required: teamsync -file roster.yaml [-dry-run] [-max-deactivations N]")
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Error("failed to read roster file", sl.Err(err))
+		os.Exit(1)
+	}
+
+	roster, err := dto.ParseRoster(data)
+	if err != nil {
+		log.Error("failed to parse roster file", sl.Err(err))
+		os.Exit(1)
+	}
+
+	if err := validator.New().Struct(roster); err != nil {
+		log.Error("invalid roster file", sl.Err(err))
+		os.Exit(1)
+	}
+
+	db, cleanup := storage.MustInit(cfg.Postgres.DatabaseURL, log)
+	defer cleanup()
+
+	trManager := manager.Must(trm.NewDefaultFactory(db))
+
+	teamRepo := repo.NewTeamRepo(db, trm.DefaultCtxGetter)
+	userRepo := repo.NewUserRepo(db, trm.DefaultCtxGetter)
+	prRepo := repo.NewPullRequestRepo(db, trm.DefaultCtxGetter, trManager)
+
+	teamService := team.NewTeamService(trManager, teamRepo, userRepo, prRepo, nil)
+
+	resp, err := teamService.Sync(context.Background(), roster, team.SyncOptions{DryRun: *dryRun, MaxDeactivations: *maxDeactivations})
+	if err != nil {
+		log.Error("failed to sync teams", sl.Err(err))
+		cleanup()
+		os.Exit(1)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(resp); err != nil {
+		log.Error("failed to print sync result", sl.Err(err))
+	}
+
+	log.Info("teams synced", slog.Bool("dry_run", *dryRun), slog.Int("changes", len(resp.Changes)))
+}
@@ -0,0 +1,31 @@
+// Package docs embeds the OpenAPI spec, so the service validates requests
+// against the same file that is published.
+package docs
+
+import (
+	"context"
+	_ "embed"
+
+	"railgorail/avito/internal/lib"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+//go:embed openapi.yml
+var OpenAPI []byte
+
+// LoadOpenAPI parses and validates the embedded spec.
+func LoadOpenAPI(ctx context.Context) (*openapi3.T, error) {
+	const op = "docs.LoadOpenAPI"
+
+	loader := openapi3.NewLoader()
+	loader.Context = ctx
+	spec, err := loader.LoadFromData(OpenAPI)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+	if err := spec.Validate(ctx); err != nil {
+		return nil, lib.Err(op, err)
+	}
+	return spec, nil
+}
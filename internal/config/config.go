@@ -7,21 +7,77 @@ import (
 )
 
 type Config struct {
-	Env        string `env:"ENV" env-default:"local"`
-	HTTPServer HTTPServer
-	Postgres   Postgres
+	Env         string `env:"ENV" env-default:"local"`
+	HTTPServer  HTTPServer
+	Postgres    Postgres
+	Directory   Directory
+	PullRequest PullRequest
+	Tracing     Tracing
 }
 
 type Postgres struct {
 	DatabaseURL string `env:"DATABASE_URL"`
 }
 
+// Tracing selects where spans go: otlp, stdout or none. The OTLP exporter
+// reads its endpoint and headers from the standard OTEL_EXPORTER_OTLP_*
+// variables.
+type Tracing struct {
+	Exporter    string  `env:"TRACING_EXPORTER" env-default:"none"`
+	ServiceName string  `env:"TRACING_SERVICE_NAME" env-default:"reviewer-service"`
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
+
+// PullRequest holds the PR workflow settings. StackMergePolicy is block or
+// allow, it decides whether a stacked PR may be merged before its parent.
+// ReconcileInterval is how often pending reviewer seats are retried.
+type PullRequest struct {
+	StackMergePolicy  string        `env:"PR_STACK_MERGE_POLICY" env-default:"block"`
+	ReconcileInterval time.Duration `env:"PR_RECONCILE_INTERVAL" env-default:"1m"`
+}
+
+// Directory configures the periodic team sync from a company directory.
+// An empty Provider turns the sync off. A run that would deactivate more
+// than MaxDeactivations users is aborted, 0 turns the limit off.
+type Directory struct {
+	Provider         string            `env:"DIRECTORY_PROVIDER"`
+	SyncInterval     time.Duration     `env:"DIRECTORY_SYNC_INTERVAL" env-default:"15m"`
+	GroupMapping     map[string]string `env:"DIRECTORY_GROUP_MAPPING"`
+	File             string            `env:"DIRECTORY_FILE"`
+	MaxDeactivations int               `env:"DIRECTORY_MAX_DEACTIVATIONS" env-default:"10"`
+	LDAP             LDAP
+}
+
+type LDAP struct {
+	URL             string        `env:"LDAP_URL"`
+	BindDN          string        `env:"LDAP_BIND_DN"`
+	BindPassword    string        `env:"LDAP_BIND_PASSWORD"`
+	BaseDN          string        `env:"LDAP_BASE_DN"`
+	StartTLS        bool          `env:"LDAP_START_TLS" env-default:"false"`
+	Timeout         time.Duration `env:"LDAP_TIMEOUT" env-default:"10s"`
+	UserFilter      string        `env:"LDAP_USER_FILTER" env-default:"(objectClass=person)"`
+	UserIDAttr      string        `env:"LDAP_USER_ID_ATTR" env-default:"uid"`
+	UserNameAttr    string        `env:"LDAP_USER_NAME_ATTR" env-default:"cn"`
+	GroupFilter     string        `env:"LDAP_GROUP_FILTER" env-default:"(objectClass=groupOfNames)"`
+	GroupNameAttr   string        `env:"LDAP_GROUP_NAME_ATTR" env-default:"cn"`
+	GroupMemberAttr string        `env:"LDAP_GROUP_MEMBER_ATTR" env-default:"member"`
+}
+
 type HTTPServer struct {
 	Address         string        `env:"HTTP_SERVER_ADDRESS" env-default:"0.0.0.0:8080"`
 	ReadTimeout     time.Duration `env:"HTTP_SERVER_READ_TIMEOUT" env-default:"5s"`
 	WriteTimeout    time.Duration `env:"HTTP_SERVER_WRITE_TIMEOUT" env-default:"10s"`
 	IdleTimeout     time.Duration `env:"HTTP_SERVER_IDLE_TIMEOUT" env-default:"60s"`
 	ShutdownTimeout time.Duration `env:"HTTP_SERVER_SHUTDOWN_TIMEOUT" env-default:"15s"`
+	ShutdownDelay   time.Duration `env:"HTTP_SERVER_SHUTDOWN_DELAY" env-default:"5s"`
+	ReadyTimeout    time.Duration `env:"HTTP_READY_TIMEOUT" env-default:"2s"`
+	IdempotencyTTL  time.Duration `env:"HTTP_IDEMPOTENCY_TTL" env-default:"24h"`
+	// IdempotencyPurgeInterval is how often keys older than IdempotencyTTL
+	// are deleted
+	IdempotencyPurgeInterval time.Duration `env:"HTTP_IDEMPOTENCY_PURGE_INTERVAL" env-default:"1h"`
+	// ValidateResponses checks responses against docs/openapi.yml and logs
+	// mismatches, meant for local and dev
+	ValidateResponses bool `env:"HTTP_VALIDATE_RESPONSES" env-default:"false"`
 }
 
 func MustLoad() *Config {
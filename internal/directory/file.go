@@ -0,0 +1,63 @@
+package directory
+
+import (
+	"context"
+	"os"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib"
+
+	"sigs.k8s.io/yaml"
+)
+
+// FileProvider reads the directory from a YAML or JSON file. It stands in for
+// LDAP in local setups and tests, the file is re-read on every Fetch:
+//
+//	users:
+//	  - id: u1
+//	    name: Alice
+//	groups:
+//	  - name: backend
+//	    members: [u1]
+type FileProvider struct {
+	path string
+}
+
+func NewFileProvider(path string) *FileProvider {
+	return &FileProvider{path: path}
+}
+
+type fileDirectory struct {
+	Users []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"users"`
+	Groups []struct {
+		Name    string   `json:"name"`
+		Members []string `json:"members"`
+	} `json:"groups"`
+}
+
+func (p *FileProvider) Fetch(_ context.Context) (*entity.Directory, error) {
+	const op = "file_provider.Fetch"
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	var file fileDirectory
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	dir := &entity.Directory{}
+	for _, u := range file.Users {
+		dir.Users = append(dir.Users, entity.DirectoryUser{ID: u.ID, Name: u.Name})
+	}
+	for _, g := range file.Groups {
+		dir.Groups = append(dir.Groups, entity.DirectoryGroup{Name: g.Name, MemberIDs: g.Members})
+	}
+
+	return dir, nil
+}
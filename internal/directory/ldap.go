@@ -0,0 +1,134 @@
+package directory
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strings"
+
+	"railgorail/avito/internal/config"
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+const ldapPageSize = 500
+
+// LDAPProvider reads people and groups from an LDAP server. Group members may
+// be given as DNs (groupOfNames, AD groups) or as plain user ids (posixGroup).
+type LDAPProvider struct {
+	cfg config.LDAP
+}
+
+func NewLDAPProvider(cfg config.LDAP) *LDAPProvider {
+	return &LDAPProvider{cfg: cfg}
+}
+
+func (p *LDAPProvider) Fetch(ctx context.Context) (*entity.Directory, error) {
+	const op = "ldap_provider.Fetch"
+
+	conn, err := p.connect()
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+	defer conn.Close()
+
+	// go-ldap has no context support, closing the connection aborts the search
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	users, err := conn.SearchWithPaging(ldap.NewSearchRequest(
+		p.cfg.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		p.cfg.UserFilter, []string{p.cfg.UserIDAttr, p.cfg.UserNameAttr}, nil,
+	), ldapPageSize)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	dir := &entity.Directory{}
+	idsByDN := make(map[string]string, len(users.Entries))
+	ids := make(map[string]struct{}, len(users.Entries))
+	for _, e := range users.Entries {
+		id := e.GetAttributeValue(p.cfg.UserIDAttr)
+		if id == "" {
+			continue
+		}
+		name := e.GetAttributeValue(p.cfg.UserNameAttr)
+		if name == "" {
+			name = id
+		}
+		dir.Users = append(dir.Users, entity.DirectoryUser{ID: id, Name: name})
+		idsByDN[normalizeDN(e.DN)] = id
+		ids[id] = struct{}{}
+	}
+
+	groups, err := conn.SearchWithPaging(ldap.NewSearchRequest(
+		p.cfg.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		p.cfg.GroupFilter, []string{p.cfg.GroupNameAttr, p.cfg.GroupMemberAttr}, nil,
+	), ldapPageSize)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	for _, e := range groups.Entries {
+		group := entity.DirectoryGroup{Name: e.GetAttributeValue(p.cfg.GroupNameAttr)}
+		for _, member := range e.GetAttributeValues(p.cfg.GroupMemberAttr) {
+			if id, ok := idsByDN[normalizeDN(member)]; ok {
+				group.MemberIDs = append(group.MemberIDs, id)
+			} else if _, ok := ids[member]; ok {
+				group.MemberIDs = append(group.MemberIDs, member)
+			}
+		}
+		dir.Groups = append(dir.Groups, group)
+	}
+
+	return dir, nil
+}
+
+func (p *LDAPProvider) connect() (*ldap.Conn, error) {
+	conn, err := ldap.DialURL(p.cfg.URL, ldap.DialWithDialer(&net.Dialer{Timeout: p.cfg.Timeout}))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(p.cfg.Timeout)
+
+	if p.cfg.StartTLS {
+		u, err := url.Parse(p.cfg.URL)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if err := conn.StartTLS(&tls.Config{ServerName: u.Hostname()}); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	if p.cfg.BindDN != "" {
+		if err := conn.Bind(p.cfg.BindDN, p.cfg.BindPassword); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+// normalizeDN makes DNs comparable regardless of case and spacing.
+func normalizeDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(dn)
+	}
+	parts := make([]string, 0, len(parsed.RDNs))
+	for _, rdn := range parsed.RDNs {
+		attrs := make([]string, 0, len(rdn.Attributes))
+		for _, a := range rdn.Attributes {
+			attrs = append(attrs, strings.ToLower(a.Type)+"="+strings.ToLower(a.Value))
+		}
+		parts = append(parts, strings.Join(attrs, "+"))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,17 @@
+package entity
+
+// Directory is a snapshot of people and groups in the company directory.
+type Directory struct {
+	Users  []DirectoryUser
+	Groups []DirectoryGroup
+}
+
+type DirectoryUser struct {
+	ID   string
+	Name string
+}
+
+type DirectoryGroup struct {
+	Name      string
+	MemberIDs []string
+}
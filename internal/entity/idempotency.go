@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// IdempotencyRecord is the stored outcome of a request sent with an
+// Idempotency-Key. StatusCode is nil while the request is being handled.
+type IdempotencyRecord struct {
+	Key          string     `db:"key"`
+	RequestHash  string     `db:"request_hash"`
+	StatusCode   *int       `db:"status_code"`
+	ContentType  string     `db:"content_type"`
+	ResponseBody []byte     `db:"response_body"`
+	CreatedAt    *time.Time `db:"created_at"`
+}
@@ -1,12 +1,124 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
 
 type PullRequest struct {
-	ID        string     `db:"id"`
-	Title     string     `db:"title"`
-	AuthorId  string     `db:"author_id"`
-	Status    string     `db:"status"`
-	CreatedAt *time.Time `db:"created_at"`
-	MergedAt  *time.Time `db:"merged_at"`
+	ID          string         `db:"id"`
+	Title       string         `db:"title"`
+	AuthorId    string         `db:"author_id"`
+	TeamID      int            `db:"team_id"`
+	Status      string         `db:"status"`
+	CreatedAt   *time.Time     `db:"created_at"`
+	MergedAt    *time.Time     `db:"merged_at"`
+	Description string         `db:"description"`
+	Labels      pq.StringArray `db:"labels"`
+	URL         string         `db:"url"`
+	Size        int            `db:"size"`
+	ParentID    *string        `db:"parent_id"`
+	// PendingReviewers is the number of reviewer seats still waiting for a
+	// candidate.
+	PendingReviewers int `db:"pending_reviewers"`
+	PullRequestSize
+}
+
+// PullRequestSize is what the author reports about the diff on creation, every
+// field is optional.
+type PullRequestSize struct {
+	Additions    *int `db:"additions"`
+	Deletions    *int `db:"deletions"`
+	FilesChanged *int `db:"files_changed"`
+}
+
+// Lines is the number of changed lines, ok is false when neither additions
+// nor deletions were reported.
+func (s PullRequestSize) Lines() (lines int, ok bool) {
+	if s.Additions == nil && s.Deletions == nil {
+		return 0, false
+	}
+	if s.Additions != nil {
+		lines += *s.Additions
+	}
+	if s.Deletions != nil {
+		lines += *s.Deletions
+	}
+	return lines, true
+}
+
+const PullRequestEventSlotFilled = "reviewer_slot_filled"
+
+// Reasons a reviewer stopped reviewing a PR before the merge.
+const (
+	UnassignReassigned  = "reassigned"
+	UnassignDeactivated = "deactivated"
+	UnassignLeftTeam    = "left_team"
+	UnassignUserDeleted = "user_deleted"
+)
+
+// PullRequestEvent is an entry of a PR's history.
+type PullRequestEvent struct {
+	ID            int64      `db:"id"`
+	PullRequestID string     `db:"pull_request_id"`
+	Type          string     `db:"type"`
+	UserID        *string    `db:"user_id"`
+	Details       string     `db:"details"`
+	CreatedAt     *time.Time `db:"created_at"`
+}
+
+// PullRequestUpdate holds the editable PR fields, nil ones are left as they
+// are. An empty non-nil Labels clears the labels.
+type PullRequestUpdate struct {
+	Title       *string
+	Description *string
+	Labels      []string
+	URL         *string
+	Size        *int
+}
+
+const (
+	PullRequestSortCreatedAt = "created_at"
+	PullRequestSortMergedAt  = "merged_at"
+)
+
+// PullRequestFilter narrows /pullRequest/list. Empty fields are not applied,
+// time ranges include From and exclude To.
+type PullRequestFilter struct {
+	Status      string
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	Label       string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+	SortBy      string
+	Desc        bool
+	Limit       int
+	After       *PullRequestCursor
+}
+
+// PullRequestCursor is the sort key of the last item on the previous page.
+// Value is a timestamp or "infinity" for PRs that are not merged yet.
+type PullRequestCursor struct {
+	Value string
+	ID    string
+}
+
+// Review is a PR seen from one of its reviewers.
+type Review struct {
+	PullRequest
+	AssignedAt *time.Time `db:"assigned_at"`
+}
+
+// ReviewFilter narrows /users/getReview, reviews are ordered by assigned_at.
+type ReviewFilter struct {
+	UserID   string
+	Statuses []string
+	Desc     bool
+	Limit    int
+	After    *PullRequestCursor
 }
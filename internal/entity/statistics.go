@@ -1,9 +1,46 @@
 package entity
 
+import "time"
+
+const (
+	StatsGroupByUser = "user"
+	StatsGroupByTeam = "team"
+	StatsGroupByDay  = "day"
+	StatsGroupByWeek = "week"
+)
+
+// StatsFilter narrows /stats. Assignments are counted by assigned_at and PRs
+// by created_at, From is included and To is not. TeamName limits both to the
+// team's PRs and the users to its members.
+type StatsFilter struct {
+	Sort     string
+	TeamName string
+	From     *time.Time
+	To       *time.Time
+	GroupBy  string
+}
+
+// UserStatistics counts current reviews in AssignmentCount and WeightedLoad.
+// The other counts cover every assignment made in the window: Assigned is
+// all of them, Reassigned the ones taken away, Completed the ones that lasted
+// until the merge and Open the ones still waiting for it.
 type UserStatistics struct {
 	UserID          string `db:"user_id"`
 	Username        string `db:"username"`
 	AssignmentCount int    `db:"assignment_count"`
+	WeightedLoad    int    `db:"weighted_load"`
+	Assigned        int    `db:"assigned_count"`
+	Reassigned      int    `db:"reassigned_count"`
+	Completed       int    `db:"completed_count"`
+	Open            int    `db:"open_count"`
+}
+
+// GroupStatistics is the assignment load of a team, a day or a week. Key is
+// the team name or the first day of the period.
+type GroupStatistics struct {
+	Key             string `db:"key"`
+	AssignmentCount int    `db:"assignment_count"`
+	WeightedLoad    int    `db:"weighted_load"`
 }
 
 type PrStatistics struct {
@@ -11,3 +48,45 @@ type PrStatistics struct {
 	OpenPrs   int `db:"open_pr_count"`
 	MergedPrs int `db:"merged_pr_count"`
 }
+
+type TeamPrStatistics struct {
+	TeamName string `db:"team_name"`
+	PrStatistics
+}
+
+// Percentiles are in seconds, Count is the number of samples.
+type Percentiles struct {
+	Count int     `db:"count"`
+	P50   float64 `db:"p50"`
+	P90   float64 `db:"p90"`
+	P99   float64 `db:"p99"`
+}
+
+// TeamLatency is the time from creation to merge of a team's PRs.
+type TeamLatency struct {
+	TeamName string `db:"team_name"`
+	Percentiles
+}
+
+// ReviewerLatency is the time from assignment to merge of a reviewer's PRs.
+type ReviewerLatency struct {
+	UserID   string `db:"user_id"`
+	Username string `db:"username"`
+	Percentiles
+}
+
+// WeeklyThroughput is the number of PRs merged in the week starting on Week.
+type WeeklyThroughput struct {
+	Week   string `db:"week"`
+	Merged int    `db:"merged_count"`
+}
+
+// MemberLoad is what a team member reviewed for the team in a window and for
+// how many days of it they were a member and active.
+type MemberLoad struct {
+	TeamName        string  `db:"team_name"`
+	UserID          string  `db:"user_id"`
+	Username        string  `db:"username"`
+	AssignmentCount int     `db:"assignment_count"`
+	ActiveDays      float64 `db:"active_days"`
+}
@@ -7,3 +7,37 @@ type Team struct {
 	Name      string     `db:"name"`
 	CreatedAt *time.Time `db:"created_at"`
 }
+
+type TeamMembership struct {
+	TeamID   int    `db:"team_id"`
+	TeamName string `db:"team_name"`
+	UserID   string `db:"user_id"`
+}
+
+// SizeTier decides how many reviewers a team's PR gets by its size in lines.
+// A nil MaxLines matches PRs of any size.
+type SizeTier struct {
+	TeamID          int  `db:"team_id"`
+	MaxLines        *int `db:"max_lines"`
+	Reviewers       int  `db:"reviewers"`
+	SeniorReviewers int  `db:"senior_reviewers"`
+}
+
+const (
+	RuleActionAddTeamMember = "add_team_member"
+	RuleActionSetReviewers  = "set_reviewers"
+)
+
+// RoutingRule fires on PRs of TeamID that carry Label. add_team_member asks
+// for a reviewer from TargetTeamID, set_reviewers overrides how many reviewers
+// the PR gets.
+type RoutingRule struct {
+	ID             int        `db:"id"`
+	TeamID         int        `db:"team_id"`
+	Label          string     `db:"label"`
+	Action         string     `db:"action"`
+	TargetTeamID   *int       `db:"target_team_id"`
+	TargetTeamName *string    `db:"target_team_name"`
+	Reviewers      *int       `db:"reviewers"`
+	CreatedAt      *time.Time `db:"created_at"`
+}
@@ -7,7 +7,14 @@ import (
 type User struct {
 	ID        string     `db:"id"`
 	Name      string     `db:"name"`
-	TeamID    int        `db:"team_id"`
 	IsActive  bool       `db:"is_active"`
+	IsSenior  bool       `db:"is_senior"`
 	CreatedAt *time.Time `db:"created_at"`
 }
+
+type UserFilter struct {
+	TeamName string
+	IsActive *bool
+	Limit    int
+	Offset   int
+}
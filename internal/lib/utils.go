@@ -1,7 +1,41 @@
 package lib
 
-import "fmt"
+import (
+	"fmt"
+	"math/rand/v2"
+)
 
 func Err(op string, err error) error {
 	return fmt.Errorf("%s: %w", op, err)
 }
+
+func RandomUsers(candidates []string, maxCount int, excludedIDs ...string) []string {
+	if len(candidates) == 0 || maxCount <= 0 {
+		return []string{}
+	}
+
+	excluded := make(map[string]struct{}, len(excludedIDs))
+	for _, id := range excludedIDs {
+		excluded[id] = struct{}{}
+	}
+
+	var available []string
+	for _, user := range candidates {
+		if _, skip := excluded[user]; !skip {
+			available = append(available, user)
+		}
+	}
+
+	if len(available) == 0 {
+		return []string{}
+	}
+
+	rand.Shuffle(len(available), func(i, j int) {
+		available[i], available[j] = available[j], available[i]
+	})
+
+	if len(available) > maxCount {
+		return available[:maxCount]
+	}
+	return available
+}
@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"railgorail/avito/internal/service"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "reviewer"
+
+// Registry holds every metric of the service, Handler serves it.
+var Registry = prometheus.NewRegistry()
+
+// latencyBuckets has a bound at 300ms, the assignment SLI target.
+var latencyBuckets = []float64{.005, .01, .025, .05, .1, .2, .3, .5, 1, 2.5, 5}
+
+var (
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	HTTPDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request duration by method, route pattern and status code.",
+		Buckets:   latencyBuckets,
+	}, []string{"method", "route", "status"})
+
+	TransactionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "transaction_duration_seconds",
+		Help:      "Duration of service transactions by outcome.",
+		Buckets:   latencyBuckets,
+	}, []string{"outcome"})
+
+	PullRequestsCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pull_requests_created_total",
+		Help:      "Pull requests created.",
+	})
+
+	PullRequestsMerged = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pull_requests_merged_total",
+		Help:      "Pull requests merged.",
+	})
+
+	Reassignments = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reassignments_total",
+		Help:      "Reviews handed over to another reviewer by reason.",
+	}, []string{"reason"})
+
+	NoCandidate = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "no_candidate_total",
+		Help:      "Reviewer seats left empty for lack of candidates by operation.",
+	}, []string{"operation"})
+)
+
+// Operations that can run out of candidates.
+const (
+	OperationCreate   = "create"
+	OperationReassign = "reassign"
+	OperationRelease  = "release"
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPDuration,
+		TransactionDuration,
+		PullRequestsCreated,
+		PullRequestsMerged,
+		Reassignments,
+		NoCandidate,
+	)
+}
+
+// Released counts a review taken from a reviewer, replacedBy is empty when
+// nobody could take it over.
+func Released(reason, replacedBy string) {
+	if replacedBy == "" {
+		NoCandidate.WithLabelValues(OperationRelease).Inc()
+		return
+	}
+	Reassignments.WithLabelValues(reason).Inc()
+}
+
+// RegisterDB exposes the pool stats of db.
+func RegisterDB(db *sql.DB, name string) {
+	Registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+type txKey struct{}
+
+// TransactionManager times the outermost Do, nested calls join its
+// transaction and are not timed again.
+type TransactionManager struct {
+	trm service.TransactionManager
+}
+
+func NewTransactionManager(trm service.TransactionManager) *TransactionManager {
+	return &TransactionManager{trm: trm}
+}
+
+func (m *TransactionManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(txKey{}) != nil {
+		return m.trm.Do(ctx, fn)
+	}
+
+	start := time.Now()
+	err := m.trm.Do(context.WithValue(ctx, txKey{}, true), fn)
+
+	outcome := "commit"
+	if err != nil {
+		outcome = "rollback"
+	}
+	TransactionDuration.WithLabelValues(outcome).Observe(time.Since(start).Seconds())
+
+	return err
+}
@@ -3,7 +3,10 @@ package repo
 import "errors"
 
 const (
-	uniqueViolationCode = "23505"
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+
+	prAuthorConstraint = "pull_requests_author_id_fkey"
 )
 
 var (
@@ -14,4 +17,20 @@ var (
 	ErrPRMerged    = errors.New("cannot reassign on merged PR")
 	ErrNotAssigned = errors.New("reviewer is not assigned to this PR")
 	ErrNoCandidate = errors.New("no active replacement candidate in team")
+
+	ErrHasOpenReviews = errors.New("user has open reviews")
+	ErrUserIsAuthor   = errors.New("user is author of pull requests")
+
+	ErrTeamRequired  = errors.New("author is a member of several teams, team_name is required")
+	ErrNotTeamMember = errors.New("author is not a member of this team")
+
+	ErrInvalidRoster        = errors.New("invalid roster")
+	ErrTooManyDeactivations = errors.New("sync would deactivate too many users")
+	ErrEmptyDirectory       = errors.New("directory has no members in the mapped groups")
+	ErrInvalidSizeTiers     = errors.New("invalid size tiers")
+	ErrInvalidRule          = errors.New("invalid routing rule")
+	ErrRuleExists           = errors.New("routing rule already exists")
+
+	ErrInvalidParent   = errors.New("invalid parent PR")
+	ErrParentNotMerged = errors.New("parent PR is not merged yet")
 )
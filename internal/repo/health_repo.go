@@ -0,0 +1,45 @@
+package repo
+
+import (
+	"context"
+
+	"railgorail/avito/internal/lib"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// HealthRepo backs the readiness probe, it never joins a transaction.
+type HealthRepo struct {
+	db *sqlx.DB
+}
+
+func NewHealthRepo(db *sqlx.DB) *HealthRepo {
+	return &HealthRepo{
+		db: db,
+	}
+}
+
+func (r *HealthRepo) Ping(ctx context.Context) error {
+	const op = "health_repo.Ping"
+
+	if err := r.db.PingContext(ctx); err != nil {
+		return lib.Err(op, err)
+	}
+	return nil
+}
+
+// MigrationVersion reads the version golang-migrate left in the database.
+// dirty is set when a migration failed halfway.
+func (r *HealthRepo) MigrationVersion(ctx context.Context) (uint, bool, error) {
+	const op = "health_repo.MigrationVersion"
+
+	var (
+		version uint
+		dirty   bool
+	)
+	err := r.db.QueryRowxContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1;`).Scan(&version, &dirty)
+	if err != nil {
+		return 0, false, lib.Err(op, err)
+	}
+	return version, dirty, nil
+}
@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// IdempotencyRepo works outside of transactions: a key has to stay reserved
+// while the handler runs its own.
+type IdempotencyRepo struct {
+	db *sqlx.DB
+}
+
+func NewIdempotencyRepo(db *sqlx.DB) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		db: db,
+	}
+}
+
+// Reserve claims key for a new request. A key older than ttl is claimed anew.
+// When the key is taken the stored record is returned with created false.
+func (r *IdempotencyRepo) Reserve(ctx context.Context, key, requestHash string, ttl time.Duration) (*entity.IdempotencyRecord, bool, error) {
+	const op = "idempotency_repo.Reserve"
+
+	query := `
+		INSERT INTO idempotency_keys (key, request_hash)
+		VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+		    status_code = NULL,
+		    content_type = '',
+		    response_body = NULL,
+		    created_at = CURRENT_TIMESTAMP
+		WHERE idempotency_keys.created_at < CURRENT_TIMESTAMP - make_interval(secs => $3)
+		RETURNING key;
+	`
+
+	var reserved string
+	err := r.db.QueryRowContext(ctx, query, key, requestHash, ttl.Seconds()).Scan(&reserved)
+	if err == nil {
+		return nil, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, lib.Err(op, err)
+	}
+
+	var record entity.IdempotencyRecord
+	err = r.db.GetContext(ctx, &record, `
+		SELECT key, request_hash, status_code, content_type, response_body, created_at
+		FROM idempotency_keys
+		WHERE key = $1;
+	`, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// released between the two statements
+			return r.Reserve(ctx, key, requestHash, ttl)
+		}
+		return nil, false, lib.Err(op, err)
+	}
+
+	return &record, false, nil
+}
+
+func (r *IdempotencyRepo) Complete(ctx context.Context, key string, statusCode int, contentType string, body []byte) error {
+	const op = "idempotency_repo.Complete"
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $2, content_type = $3, response_body = $4
+		WHERE key = $1;
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, key, statusCode, contentType, body); err != nil {
+		return lib.Err(op, err)
+	}
+
+	return nil
+}
+
+// Release frees a key whose request failed, so that a retry runs again.
+func (r *IdempotencyRepo) Release(ctx context.Context, key string) error {
+	const op = "idempotency_repo.Release"
+
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE key = $1 AND status_code IS NULL;
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, key); err != nil {
+		return lib.Err(op, err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes keys older than ttl and returns how many were removed.
+func (r *IdempotencyRepo) DeleteExpired(ctx context.Context, ttl time.Duration) (int64, error) {
+	const op = "idempotency_repo.DeleteExpired"
+
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE created_at < CURRENT_TIMESTAMP - make_interval(secs => $1);
+	`
+
+	res, err := r.db.ExecContext(ctx, query, ttl.Seconds())
+	if err != nil {
+		return 0, lib.Err(op, err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, lib.Err(op, err)
+	}
+
+	return deleted, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	"railgorail/avito/internal/entity"
 	"railgorail/avito/internal/lib"
@@ -19,13 +20,26 @@ type PullRequestRepository interface {
 	Create(ctx context.Context, pr *entity.PullRequest) (string, error)
 	GetById(ctx context.Context, prID string) (*entity.PullRequest, error)
 	GetByAuthor(ctx context.Context, authorID string) ([]*entity.PullRequest, error)
+	List(ctx context.Context, filter entity.PullRequestFilter) ([]*entity.PullRequest, error)
 	MarkAsMerged(ctx context.Context, prID string) error
-
-	GetReviewers(ctx context.Context, prID string) ([]string, error)
+	Update(ctx context.Context, prID string, upd entity.PullRequestUpdate) error
+	SetLabels(ctx context.Context, prID string, labels []string) error
+	GetStack(ctx context.Context, prID string) ([]*entity.PullRequest, error)
+	GetWithPendingReviewers(ctx context.Context) ([]*entity.PullRequest, error)
+	GetWithPendingReviewersInTeam(ctx context.Context, teamID int) ([]*entity.PullRequest, error)
+	SetPendingReviewers(ctx context.Context, prID string, pending int) error
+	AddEvent(ctx context.Context, event *entity.PullRequestEvent) error
+	GetEvents(ctx context.Context, prID string) ([]*entity.PullRequestEvent, error)
+
+	GetPrReviewers(ctx context.Context, prID string) ([]string, error)
+	GetReviewersByPrIDs(ctx context.Context, prIDs []string) (map[string][]string, error)
 	AssignReviewer(ctx context.Context, prID, userID string) error
-	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) error
+	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID, reason string) error
+	DeleteReviewer(ctx context.Context, prID, userID, reason string) error
 }
 
+var _ PullRequestRepository = (*PullRequestRepo)(nil)
+
 type PullRequestRepo struct {
 	db     *sqlx.DB
 	getter *trm.CtxGetter
@@ -44,9 +58,13 @@ func (r *PullRequestRepo) Create(ctx context.Context, pr *entity.PullRequest) (s
 	const op = "pull_request_repo.Create"
 
 	query := `
-        INSERT INTO pull_requests (id, title, author_id, status, created_at)
-        VALUES ($1, $2, $3, $4, now())
-        RETURNING id;
+        WITH ins AS (
+            INSERT INTO pull_requests (id, title, author_id, team_id, status, created_at,
+                                       size, additions, deletions, files_changed, parent_id, pending_reviewers)
+            VALUES ($1, $2, $3, $4, $5, now(), $6, $7, $8, $9, $10, $11)
+            RETURNING id, team_id, created_at
+        ), rollup AS (` + prRollup("ins", "1", "0") + `)
+        SELECT id FROM ins;
     `
 
 	var prID string
@@ -56,7 +74,14 @@ func (r *PullRequestRepo) Create(ctx context.Context, pr *entity.PullRequest) (s
 		pr.ID,
 		pr.Title,
 		pr.AuthorId,
+		pr.TeamID,
 		pr.Status,
+		pr.Size,
+		pr.Additions,
+		pr.Deletions,
+		pr.FilesChanged,
+		pr.ParentID,
+		pr.PendingReviewers,
 	).Scan(&prID)
 
 	if err != nil {
@@ -76,7 +101,9 @@ func (r *PullRequestRepo) GetById(ctx context.Context, prID string) (*entity.Pul
 	const op = "pull_request_repo.GetById"
 
 	query := `
-        SELECT id, title, author_id, status, created_at, merged_at
+        SELECT id, title, author_id, team_id, status, created_at, merged_at,
+               description, url, size, additions, deletions, files_changed, parent_id, pending_reviewers,
+               ARRAY(SELECT l.label FROM pr_labels l WHERE l.pull_request_id = pull_requests.id ORDER BY l.label) AS labels
         FROM pull_requests
         WHERE id = $1
     `
@@ -93,16 +120,316 @@ func (r *PullRequestRepo) GetById(ctx context.Context, prID string) (*entity.Pul
 	return &pr, nil
 }
 
+func (r *PullRequestRepo) GetByAuthor(ctx context.Context, authorID string) ([]*entity.PullRequest, error) {
+	const op = "pull_request_repo.GetByAuthor"
+
+	query := `
+        SELECT id, title, author_id, team_id, status, created_at, merged_at,
+               description, url, size, additions, deletions, files_changed, parent_id, pending_reviewers,
+               ARRAY(SELECT l.label FROM pr_labels l WHERE l.pull_request_id = pull_requests.id ORDER BY l.label) AS labels
+        FROM pull_requests
+        WHERE author_id = $1
+        ORDER BY created_at, id
+    `
+
+	var pullRequests []*entity.PullRequest
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &pullRequests, query, authorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.PullRequest{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return pullRequests, nil
+}
+
+// List pages through PRs with keyset pagination on (sort key, id). PRs that
+// are not merged sort after merged ones when ordering by merged_at.
+func (r *PullRequestRepo) List(ctx context.Context, filter entity.PullRequestFilter) ([]*entity.PullRequest, error) {
+	const op = "pull_request_repo.List"
+
+	var (
+		conds []string
+		args  []any
+	)
+	addCond := func(format string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(format, len(args)))
+	}
+
+	if filter.Status != "" {
+		addCond("p.status = $%d", filter.Status)
+	}
+	if filter.AuthorID != "" {
+		addCond("p.author_id = $%d", filter.AuthorID)
+	}
+	if filter.ReviewerID != "" {
+		addCond(`EXISTS (
+			SELECT 1 FROM pr_reviewers prr
+			WHERE prr.pull_request_id = p.id AND prr.user_id = $%d
+		)`, filter.ReviewerID)
+	}
+	if filter.TeamName != "" {
+		addCond("p.team_id = (SELECT id FROM teams WHERE name = $%d)", filter.TeamName)
+	}
+	if filter.Label != "" {
+		addCond(`EXISTS (
+			SELECT 1 FROM pr_labels l
+			WHERE l.pull_request_id = p.id AND l.label = $%d
+		)`, filter.Label)
+	}
+	// timestamps are stored without time zone in UTC
+	if filter.CreatedFrom != nil {
+		addCond("p.created_at >= $%d", filter.CreatedFrom.UTC())
+	}
+	if filter.CreatedTo != nil {
+		addCond("p.created_at < $%d", filter.CreatedTo.UTC())
+	}
+	if filter.MergedFrom != nil {
+		addCond("p.merged_at >= $%d", filter.MergedFrom.UTC())
+	}
+	if filter.MergedTo != nil {
+		addCond("p.merged_at < $%d", filter.MergedTo.UTC())
+	}
+
+	sortKey := "p.created_at"
+	if filter.SortBy == entity.PullRequestSortMergedAt {
+		sortKey = "COALESCE(p.merged_at, 'infinity'::timestamp)"
+	}
+	direction, cmp := "ASC", ">"
+	if filter.Desc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if filter.After != nil {
+		args = append(args, filter.After.Value, filter.After.ID)
+		conds = append(conds, fmt.Sprintf("(%s, p.id) %s ($%d::timestamp, $%d)",
+			sortKey, cmp, len(args)-1, len(args)))
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`
+		SELECT p.id, p.title, p.author_id, p.team_id, p.status, p.created_at, p.merged_at,
+		       p.description, p.url, p.size, p.additions, p.deletions, p.files_changed, p.parent_id, p.pending_reviewers,
+		       ARRAY(SELECT l.label FROM pr_labels l WHERE l.pull_request_id = p.id ORDER BY l.label) AS labels
+		FROM pull_requests p
+		%s
+		ORDER BY %s %s, p.id %s
+		LIMIT $%d;
+	`, where, sortKey, direction, direction, len(args))
+
+	var pullRequests []*entity.PullRequest
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &pullRequests, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.PullRequest{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return pullRequests, nil
+}
+
 func (r *PullRequestRepo) MarkAsMerged(ctx context.Context, prID string) error {
 	const op = "pull_request_repo.MarkAsMerged"
 
+	// only the first merge counts in the rollup and sets merged_at, merging
+	// again must not move the PR in the merge-time stats
 	query := `
-        UPDATE pull_requests
-        SET status = 'MERGED', merged_at = now()
-        WHERE id = $1
+        WITH old AS (
+            SELECT id, status FROM pull_requests WHERE id = $1 FOR UPDATE
+        ), upd AS (
+            UPDATE pull_requests p
+            SET status = 'MERGED', merged_at = COALESCE(p.merged_at, now())
+            FROM old
+            WHERE p.id = old.id
+            RETURNING p.id, p.team_id, p.created_at, old.status AS old_status
+        ), rollup AS (` + prRollup("(SELECT * FROM upd WHERE old_status = 'OPEN')", "0", "1") + `
+        ), completed AS (` + assignmentRollup(`(
+            SELECT pr.pull_request_id, pr.user_id, pr.assigned_at
+            FROM pr_reviewers pr
+            JOIN upd ON upd.id = pr.pull_request_id
+            WHERE upd.old_status = 'OPEN'
+        )`, "completed_count", "1") + `)
+        SELECT COUNT(*) FROM upd
     `
 
-	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, prID)
+	var updated int
+	err := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowContext(ctx, query, prID).Scan(&updated)
+	if err != nil {
+		return lib.Err(op, err)
+	}
+	if updated == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Update writes only the fields set in upd, labels are stored by SetLabels.
+func (r *PullRequestRepo) Update(ctx context.Context, prID string, upd entity.PullRequestUpdate) error {
+	const op = "pull_request_repo.Update"
+
+	var (
+		sets []string
+		args []any
+	)
+	addSet := func(column string, arg any) {
+		args = append(args, arg)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if upd.Title != nil {
+		addSet("title", *upd.Title)
+	}
+	if upd.Description != nil {
+		addSet("description", *upd.Description)
+	}
+	if upd.URL != nil {
+		addSet("url", *upd.URL)
+	}
+	if upd.Size != nil {
+		addSet("size", *upd.Size)
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	args = append(args, prID)
+	query := fmt.Sprintf(`UPDATE pull_requests SET %s WHERE id = $%d`, strings.Join(sets, ", "), len(args))
+
+	return r.trm.Do(ctx, func(ctx context.Context) error {
+		// reviews already counted in the rollup are reweighed by the new size
+		if upd.Size != nil {
+			reweigh := assignmentRollup(
+				"(SELECT pull_request_id, user_id, assigned_at FROM pr_reviewers WHERE pull_request_id = $1)",
+				"weighted_load", sizeWeight("$2::int")+" - "+sizeWeight("p.size"),
+			)
+			_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, reweigh, prID, *upd.Size)
+			if err != nil {
+				return lib.Err(op, err)
+			}
+		}
+
+		res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, args...)
+		if err != nil {
+			return lib.Err(op, err)
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return lib.Err(op, err)
+		}
+		if rowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// GetStack returns the whole stack prID belongs to: the root PR first, then
+// its descendants level by level.
+func (r *PullRequestRepo) GetStack(ctx context.Context, prID string) ([]*entity.PullRequest, error) {
+	const op = "pull_request_repo.GetStack"
+
+	query := `
+		WITH RECURSIVE up AS (
+			SELECT id, parent_id, 0 AS depth
+			FROM pull_requests
+			WHERE id = $1
+			UNION ALL
+			SELECT p.id, p.parent_id, up.depth + 1
+			FROM pull_requests p
+			JOIN up ON p.id = up.parent_id
+		),
+		down AS (
+			SELECT id, 0 AS depth
+			FROM up
+			WHERE depth = (SELECT max(depth) FROM up)
+			UNION ALL
+			SELECT p.id, down.depth + 1
+			FROM pull_requests p
+			JOIN down ON p.parent_id = down.id
+		)
+		SELECT p.id, p.title, p.author_id, p.team_id, p.status, p.created_at, p.merged_at,
+		       p.description, p.url, p.size, p.additions, p.deletions, p.files_changed, p.parent_id, p.pending_reviewers,
+		       ARRAY(SELECT l.label FROM pr_labels l WHERE l.pull_request_id = p.id ORDER BY l.label) AS labels
+		FROM down
+		JOIN pull_requests p ON p.id = down.id
+		ORDER BY down.depth, p.created_at, p.id;
+	`
+
+	var prs []*entity.PullRequest
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &prs, query, prID)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+	if len(prs) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return prs, nil
+}
+
+// GetWithPendingReviewers returns open PRs with unfilled reviewer seats,
+// oldest first.
+func (r *PullRequestRepo) GetWithPendingReviewers(ctx context.Context) ([]*entity.PullRequest, error) {
+	const op = "pull_request_repo.GetWithPendingReviewers"
+
+	prs, err := r.getWithPendingReviewers(ctx, "")
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	return prs, nil
+}
+
+// GetWithPendingReviewersInTeam is GetWithPendingReviewers for one team.
+func (r *PullRequestRepo) GetWithPendingReviewersInTeam(ctx context.Context, teamID int) ([]*entity.PullRequest, error) {
+	const op = "pull_request_repo.GetWithPendingReviewersInTeam"
+
+	prs, err := r.getWithPendingReviewers(ctx, "AND team_id = $1", teamID)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	return prs, nil
+}
+
+func (r *PullRequestRepo) getWithPendingReviewers(ctx context.Context, where string, args ...any) ([]*entity.PullRequest, error) {
+	query := `
+		SELECT id, title, author_id, team_id, status, created_at, merged_at, parent_id, pending_reviewers
+		FROM pull_requests
+		WHERE status = 'OPEN' AND pending_reviewers > 0 ` + where + `
+		ORDER BY created_at, id;
+	`
+
+	prs := []*entity.PullRequest{}
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &prs, query, args...)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	return prs, nil
+}
+
+func (r *PullRequestRepo) SetPendingReviewers(ctx context.Context, prID string, pending int) error {
+	const op = "pull_request_repo.SetPendingReviewers"
+
+	query := `
+		UPDATE pull_requests
+		SET pending_reviewers = $2
+		WHERE id = $1;
+	`
+
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, prID, pending)
 	if err != nil {
 		return lib.Err(op, err)
 	}
@@ -118,23 +445,101 @@ func (r *PullRequestRepo) MarkAsMerged(ctx context.Context, prID string) error {
 	return nil
 }
 
-func (r *PullRequestRepo) DeleteReviewer(ctx context.Context, prID, userID string) error {
-	const op = "pull_request_repo.DeleteReviewer"
+func (r *PullRequestRepo) AddEvent(ctx context.Context, event *entity.PullRequestEvent) error {
+	const op = "pull_request_repo.AddEvent"
 
-	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(
-		ctx,
-		`DELETE FROM pr_reviewers WHERE pull_request_id = $1 AND user_id = $2`,
-		prID, userID,
+	query := `
+		INSERT INTO pr_events (pull_request_id, type, user_id, details)
+		VALUES ($1, $2, $3, $4);
+	`
+
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query,
+		event.PullRequestID, event.Type, event.UserID, event.Details,
 	)
 	if err != nil {
+		pgErr := &pq.Error{}
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return ErrNotFound
+		}
 		return lib.Err(op, err)
 	}
 
-	rowsAffected, err := res.RowsAffected()
+	return nil
+}
+
+// GetEvents returns the PR's history, oldest first.
+func (r *PullRequestRepo) GetEvents(ctx context.Context, prID string) ([]*entity.PullRequestEvent, error) {
+	const op = "pull_request_repo.GetEvents"
+
+	query := `
+		SELECT id, pull_request_id, type, user_id, details, created_at
+		FROM pr_events
+		WHERE pull_request_id = $1
+		ORDER BY id;
+	`
+
+	var events []*entity.PullRequestEvent
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &events, query, prID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.PullRequestEvent{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return events, nil
+}
+
+// SetLabels replaces the PR's labels.
+func (r *PullRequestRepo) SetLabels(ctx context.Context, prID string, labels []string) error {
+	const op = "pull_request_repo.SetLabels"
+
+	db := r.getter.DefaultTrOrDB(ctx, r.db)
+
+	if _, err := db.ExecContext(ctx, `DELETE FROM pr_labels WHERE pull_request_id = $1`, prID); err != nil {
 		return lib.Err(op, err)
 	}
-	if rowsAffected == 0 {
+
+	if len(labels) == 0 {
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO pr_labels (pull_request_id, label)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT DO NOTHING
+	`, prID, pq.Array(labels))
+	if err != nil {
+		pgErr := &pq.Error{}
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return ErrNotFound
+		}
+		return lib.Err(op, err)
+	}
+
+	return nil
+}
+
+// DeleteReviewer removes the reviewer without a replacement. The seat of an
+// open PR becomes pending, so the reconciler fills it later.
+func (r *PullRequestRepo) DeleteReviewer(ctx context.Context, prID, userID, reason string) error {
+	const op = "pull_request_repo.DeleteReviewer"
+
+	query := unassignQuery + `, seat AS (
+			UPDATE pull_requests p
+			SET pending_reviewers = p.pending_reviewers + 1
+			FROM del
+			WHERE p.id = del.pull_request_id AND p.status = 'OPEN'
+		)
+		SELECT COUNT(*) FROM del`
+
+	var deleted int
+	err := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowContext(ctx, query, prID, userID, reason).
+		Scan(&deleted)
+	if err != nil {
+		return lib.Err(op, err)
+	}
+	if deleted == 0 {
 		return ErrNotFound
 	}
 
@@ -145,7 +550,7 @@ func (r *PullRequestRepo) GetUserReviews(ctx context.Context, userID string) ([]
 	const op = "pull_request_repo.GetUserReviews"
 
 	query := `
-		SELECT p.id, p.title, p.author_id, p.status, p.created_at, p.merged_at
+		SELECT p.id, p.title, p.author_id, p.team_id, p.status, p.created_at, p.merged_at
 		FROM pull_requests p
 		JOIN pr_reviewers prr ON prr.pull_request_id = p.id
 		WHERE prr.user_id = $1
@@ -163,6 +568,48 @@ func (r *PullRequestRepo) GetUserReviews(ctx context.Context, userID string) ([]
 	return pullRequests, nil
 }
 
+// ListUserReviews pages through the user's reviews by (assigned_at, PR id).
+func (r *PullRequestRepo) ListUserReviews(ctx context.Context, filter entity.ReviewFilter) ([]*entity.Review, error) {
+	const op = "pull_request_repo.ListUserReviews"
+
+	args := []any{filter.UserID}
+	conds := []string{"prr.user_id = $1"}
+
+	if len(filter.Statuses) > 0 {
+		args = append(args, pq.Array(filter.Statuses))
+		conds = append(conds, fmt.Sprintf("p.status = ANY($%d)", len(args)))
+	}
+
+	direction, cmp := "ASC", ">"
+	if filter.Desc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if filter.After != nil {
+		args = append(args, filter.After.Value, filter.After.ID)
+		conds = append(conds, fmt.Sprintf("(prr.assigned_at, p.id) %s ($%d::timestamp, $%d)",
+			cmp, len(args)-1, len(args)))
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`
+		SELECT p.id, p.title, p.author_id, p.team_id, p.status, p.created_at, p.merged_at, prr.assigned_at
+		FROM pull_requests p
+		JOIN pr_reviewers prr ON prr.pull_request_id = p.id
+		WHERE %s
+		ORDER BY prr.assigned_at %s, p.id %s
+		LIMIT $%d;
+	`, strings.Join(conds, " AND "), direction, direction, len(args))
+
+	var reviews []*entity.Review
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &reviews, query, args...)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	return reviews, nil
+}
+
 func (r *PullRequestRepo) GetPrReviewers(ctx context.Context, prID string) ([]string, error) {
 	const op = "pull_request_repo.GetReviewers"
 
@@ -183,15 +630,75 @@ func (r *PullRequestRepo) GetPrReviewers(ctx context.Context, prID string) ([]st
 	return userIDs, nil
 }
 
-func (r *PullRequestRepo) AssignReviewer(ctx context.Context, prID, userID string) error {
-	const op = "pull_request_repo.AssignReviewer"
+// GetReviewersByPrIDs loads reviewers of several PRs in one query.
+func (r *PullRequestRepo) GetReviewersByPrIDs(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	const op = "pull_request_repo.GetReviewersByPrIDs"
 
 	query := `
-        INSERT INTO pr_reviewers (pull_request_id, user_id)
-        VALUES ($1, $2)
-    `
+		SELECT pull_request_id, user_id FROM pr_reviewers
+		WHERE pull_request_id = ANY($1)
+		ORDER BY pull_request_id, assigned_at, user_id;
+	`
+
+	var rows []struct {
+		PullRequestID string `db:"pull_request_id"`
+		UserID        string `db:"user_id"`
+	}
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &rows, query, pq.Array(prIDs))
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	reviewers := make(map[string][]string, len(prIDs))
+	for _, row := range rows {
+		reviewers[row.PullRequestID] = append(reviewers[row.PullRequestID], row.UserID)
+	}
+
+	return reviewers, nil
+}
+
+var (
+	// assignQuery inserts reviewer $2 of PR $1, opens the assignment in the
+	// history and counts it
+	assignQuery = `
+		WITH ins AS (
+			INSERT INTO pr_reviewers (pull_request_id, user_id)
+			VALUES ($1, $2)
+			RETURNING pull_request_id, user_id, assigned_at
+		), history AS (
+			INSERT INTO reviewer_assignments (pull_request_id, user_id, assigned_at)
+			SELECT pull_request_id, user_id, assigned_at FROM ins
+		)` + assignmentRollup("ins",
+		"assignment_count", "1",
+		"weighted_load", sizeWeight("p.size"),
+		"assigned_count", "1",
+	)
+
+	// unassignQuery deletes reviewer $2 of PR $1, closes the assignment with
+	// reason $3 and moves it to the reassigned ones on the day it was counted
+	// on. It needs a final statement, del holds the deleted row.
+	unassignQuery = `
+		WITH del AS (
+			DELETE FROM pr_reviewers
+			WHERE pull_request_id = $1 AND user_id = $2
+			RETURNING pull_request_id, user_id, assigned_at
+		), history AS (
+			UPDATE reviewer_assignments ra
+			SET unassigned_at = now(), reason = $3
+			FROM del
+			WHERE ra.pull_request_id = del.pull_request_id AND ra.user_id = del.user_id
+			  AND ra.unassigned_at IS NULL
+		), rollup AS (` + assignmentRollup("del",
+		"assignment_count", "-1",
+		"weighted_load", "-"+sizeWeight("p.size"),
+		"reassigned_count", "1",
+	) + `)`
+)
+
+func (r *PullRequestRepo) AssignReviewer(ctx context.Context, prID, userID string) error {
+	const op = "pull_request_repo.AssignReviewer"
 
-	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, prID, userID)
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, assignQuery, prID, userID)
 	if err != nil {
 		return lib.Err(op, err)
 	}
@@ -199,20 +706,16 @@ func (r *PullRequestRepo) AssignReviewer(ctx context.Context, prID, userID strin
 	return nil
 }
 
-func (r *PullRequestRepo) ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) error {
+func (r *PullRequestRepo) ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID, reason string) error {
 	const op = "pull_request_repo.ReassignReviewer"
 
 	err := r.trm.Do(ctx, func(ctx context.Context) error {
-		_, err := r.db.ExecContext(ctx,
-			`DELETE FROM pr_reviewers WHERE pull_request_id=$1 AND user_id=$2`,
-			prID, oldUserID)
+		_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, unassignQuery+` SELECT 1`, prID, oldUserID, reason)
 		if err != nil {
 			return lib.Err(op, err)
 		}
 
-		_, err = r.db.ExecContext(ctx,
-			`INSERT INTO pr_reviewers (pull_request_id, user_id) VALUES ($1, $2)`,
-			prID, newUserID)
+		_, err = r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, assignQuery, prID, newUserID)
 		if err != nil {
 			pgErr := &pq.Error{}
 			if errors.As(err, &pgErr) {
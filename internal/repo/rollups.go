@@ -0,0 +1,47 @@
+package repo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sizeWeight weighs a review one unit per started 100 changed lines of the
+// PR, PRs of unknown size weigh one.
+func sizeWeight(size string) string {
+	return fmt.Sprintf("GREATEST(1, CEIL(%s / 100.0))::int", size)
+}
+
+// assignmentRollup adds to columns of stats_daily_assignments for every row
+// of source (pull_request_id, user_id, assigned_at). deltas are column and
+// expression pairs, an expression may refer to the PR as p. It is meant to be
+// a statement of a WITH query that changes the reviewers.
+func assignmentRollup(source string, deltas ...string) string {
+	var columns, values, sets []string
+	for i := 0; i+1 < len(deltas); i += 2 {
+		columns = append(columns, deltas[i])
+		values = append(values, deltas[i+1])
+		sets = append(sets, fmt.Sprintf("%[1]s = stats_daily_assignments.%[1]s + EXCLUDED.%[1]s", deltas[i]))
+	}
+
+	return fmt.Sprintf(`
+		INSERT INTO stats_daily_assignments (day, team_id, user_id, %s)
+		SELECT c.assigned_at::date, p.team_id, c.user_id, %s
+		FROM %s c
+		JOIN pull_requests p ON p.id = c.pull_request_id
+		ON CONFLICT (day, team_id, user_id) DO UPDATE SET
+			%s
+	`, strings.Join(columns, ", "), strings.Join(values, ", "), source, strings.Join(sets, ",\n\t\t\t"))
+}
+
+// prRollup adds created and merged to stats_daily_prs for every row of
+// source (team_id, created_at).
+func prRollup(source, created, merged string) string {
+	return fmt.Sprintf(`
+		INSERT INTO stats_daily_prs (day, team_id, created_count, merged_count)
+		SELECT c.created_at::date, c.team_id, %s, %s
+		FROM %s c
+		ON CONFLICT (day, team_id) DO UPDATE SET
+			created_count = stats_daily_prs.created_count + EXCLUDED.created_count,
+			merged_count = stats_daily_prs.merged_count + EXCLUDED.merged_count
+	`, created, merged, source)
+}
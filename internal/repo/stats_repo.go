@@ -7,33 +7,94 @@ import (
 	"fmt"
 	"railgorail/avito/internal/entity"
 	"railgorail/avito/internal/lib"
+	"strings"
+	"time"
 
+	trm "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
 )
 
+// StatisticsRepo reads assignment and PR counts from the daily rollups, so
+// the window of those stats is applied in whole UTC days.
 type StatisticsRepo struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	getter *trm.CtxGetter
 }
 
-func NewStatisticsRepo(db *sqlx.DB) *StatisticsRepo {
+func NewStatisticsRepo(db *sqlx.DB, c *trm.CtxGetter) *StatisticsRepo {
 	return &StatisticsRepo{
-		db: db,
+		db:     db,
+		getter: c,
 	}
 }
 
-func (r *StatisticsRepo) GetAssignmentsCountStats(ctx context.Context, sort string) ([]*entity.UserStatistics, error) {
+// statsArgs collects query arguments and hands out their placeholders.
+type statsArgs []any
+
+func (a *statsArgs) add(v any) string {
+	*a = append(*a, v)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+// rollupConds narrows a daily rollup d to the filter's window and team. The
+// window starts on the day of From and ends with the day of To, unless To is
+// midnight.
+func rollupConds(filter entity.StatsFilter, args *statsArgs) string {
+	conds := []string{"TRUE"}
+	if filter.From != nil {
+		conds = append(conds, "d.day >= "+args.add(day(*filter.From).Format(time.DateOnly))+"::date")
+	}
+	if filter.To != nil {
+		to := day(*filter.To)
+		if to.Before(filter.To.UTC()) {
+			to = to.AddDate(0, 0, 1)
+		}
+		conds = append(conds, "d.day < "+args.add(to.Format(time.DateOnly))+"::date")
+	}
+	if filter.TeamName != "" {
+		conds = append(conds, "d.team_id = (SELECT id FROM teams WHERE name = "+args.add(filter.TeamName)+")")
+	}
+	return strings.Join(conds, " AND ")
+}
+
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// GetAssignmentsCountStats sorts users by weighted load. Every user is listed,
+// or every member of the filter's team, even without assignments.
+func (r *StatisticsRepo) GetAssignmentsCountStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.UserStatistics, error) {
 	const op = "pull_request_repo.GetAssignmentsCountStats"
 
+	var args statsArgs
+	conds := rollupConds(filter, &args)
+
+	users := "TRUE"
+	if filter.TeamName != "" {
+		users = `u.id IN (
+			SELECT tm.user_id FROM team_members tm
+			JOIN teams t ON t.id = tm.team_id
+			WHERE t.name = ` + args.add(filter.TeamName) + `
+		)`
+	}
+
 	query := fmt.Sprintf(`
-		SELECT u.id as user_id, u.name as username, COUNT(pr.pull_request_id) as assignment_count
+		SELECT u.id as user_id, u.name as username,
+		       COALESCE(SUM(d.assignment_count), 0)::int as assignment_count,
+		       COALESCE(SUM(d.weighted_load), 0)::int as weighted_load,
+		       COALESCE(SUM(d.assigned_count), 0)::int as assigned_count,
+		       COALESCE(SUM(d.reassigned_count), 0)::int as reassigned_count,
+		       COALESCE(SUM(d.completed_count), 0)::int as completed_count,
+		       COALESCE(SUM(d.assignment_count - d.completed_count), 0)::int as open_count
 		FROM users u
-		LEFT JOIN pr_reviewers pr ON u.id = pr.user_id
+		LEFT JOIN stats_daily_assignments d ON d.user_id = u.id AND %s
+		WHERE %s
 		GROUP BY u.id, u.name
-		ORDER BY assignment_count %s, u.name ASC
-	`, sort)
+		ORDER BY weighted_load %s, assignment_count %s, u.name ASC
+	`, conds, users, filter.Sort, filter.Sort)
 
 	var stats []*entity.UserStatistics
-	err := r.db.SelectContext(ctx, &stats, query)
+	err := r.db.SelectContext(ctx, &stats, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return []*entity.UserStatistics{}, nil
@@ -44,21 +105,309 @@ func (r *StatisticsRepo) GetAssignmentsCountStats(ctx context.Context, sort stri
 	return stats, nil
 }
 
-func (r *StatisticsRepo) GetPrStats(ctx context.Context) (*entity.PrStatistics, error) {
+// GetGroupedAssignmentStats groups assignments by the PR's team, or by the
+// day or week they were made. Periods without assignments are left out,
+// periods go in time order and teams by load.
+func (r *StatisticsRepo) GetGroupedAssignmentStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.GroupStatistics, error) {
+	const op = "pull_request_repo.GetGroupedAssignmentStats"
+
+	var args statsArgs
+	conds := rollupConds(filter, &args)
+
+	var key, order string
+	switch filter.GroupBy {
+	case entity.StatsGroupByTeam:
+		key = "t.name"
+		order = fmt.Sprintf("weighted_load %s, assignment_count %s, key ASC", filter.Sort, filter.Sort)
+	case entity.StatsGroupByDay, entity.StatsGroupByWeek:
+		key = fmt.Sprintf("to_char(date_trunc('%s', d.day), 'YYYY-MM-DD')", filter.GroupBy)
+		order = "key ASC"
+	default:
+		return nil, lib.Err(op, fmt.Errorf("unknown group_by %q", filter.GroupBy))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s as key, SUM(d.assignment_count)::int as assignment_count, SUM(d.weighted_load)::int as weighted_load
+		FROM stats_daily_assignments d
+		JOIN teams t ON t.id = d.team_id
+		WHERE %s
+		GROUP BY key
+		HAVING SUM(d.assignment_count) > 0
+		ORDER BY %s
+	`, key, conds, order)
+
+	var stats []*entity.GroupStatistics
+	err := r.db.SelectContext(ctx, &stats, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.GroupStatistics{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return stats, nil
+}
+
+// GetPrStats counts PRs created in the window, merged ones are those of them
+// merged by now.
+func (r *StatisticsRepo) GetPrStats(ctx context.Context, filter entity.StatsFilter) (*entity.PrStatistics, error) {
 	const op = "pull_request_repo.GetPrStats"
 
+	var args statsArgs
 	query := `
 		SELECT
-		COUNT(*) as pr_count,
-		COUNT(CASE WHEN status = 'OPEN' THEN 1 END) as open_pr_count,
-		COUNT(CASE WHEN status = 'MERGED' THEN 1 END) as merged_pr_count
-		FROM pull_requests
-	`
+		COALESCE(SUM(d.created_count), 0) as pr_count,
+		COALESCE(SUM(d.created_count - d.merged_count), 0) as open_pr_count,
+		COALESCE(SUM(d.merged_count), 0) as merged_pr_count
+		FROM stats_daily_prs d
+		WHERE ` + rollupConds(filter, &args)
 
 	var res entity.PrStatistics
-	err := r.db.GetContext(ctx, &res, query)
+	err := r.db.GetContext(ctx, &res, query, args...)
 	if err != nil {
 		return nil, lib.Err(op, err)
 	}
 	return &res, nil
 }
+
+// GetTeamPrStats counts PRs by status for every team with PRs in the window.
+func (r *StatisticsRepo) GetTeamPrStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.TeamPrStatistics, error) {
+	const op = "pull_request_repo.GetTeamPrStats"
+
+	var args statsArgs
+	query := `
+		SELECT
+		t.name as team_name,
+		SUM(d.created_count) as pr_count,
+		SUM(d.created_count - d.merged_count) as open_pr_count,
+		SUM(d.merged_count) as merged_pr_count
+		FROM stats_daily_prs d
+		JOIN teams t ON t.id = d.team_id
+		WHERE ` + rollupConds(filter, &args) + `
+		GROUP BY t.name
+		HAVING SUM(d.created_count) > 0
+		ORDER BY t.name
+	`
+
+	var stats []*entity.TeamPrStatistics
+	err := r.db.SelectContext(ctx, &stats, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.TeamPrStatistics{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return stats, nil
+}
+
+// mergedConds narrows pull_requests p joined with teams t to PRs merged in
+// the filter's window by the filter's team.
+func mergedConds(filter entity.StatsFilter, args *statsArgs) string {
+	conds := []string{"p.status = 'MERGED'", "p.merged_at IS NOT NULL"}
+	if filter.From != nil {
+		conds = append(conds, "p.merged_at >= "+args.add(filter.From.UTC()))
+	}
+	if filter.To != nil {
+		conds = append(conds, "p.merged_at < "+args.add(filter.To.UTC()))
+	}
+	if filter.TeamName != "" {
+		conds = append(conds, "t.name = "+args.add(filter.TeamName))
+	}
+	return strings.Join(conds, " AND ")
+}
+
+// percentiles of the epoch seconds in expr
+func percentiles(expr string) string {
+	return fmt.Sprintf(`
+		COUNT(*) as count,
+		percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM %[1]s)) as p50,
+		percentile_cont(0.9) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM %[1]s)) as p90,
+		percentile_cont(0.99) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM %[1]s)) as p99
+	`, expr)
+}
+
+// GetMergeLatency reports time-to-merge per team over PRs merged in the window.
+func (r *StatisticsRepo) GetMergeLatency(ctx context.Context, filter entity.StatsFilter) ([]*entity.TeamLatency, error) {
+	const op = "pull_request_repo.GetMergeLatency"
+
+	var args statsArgs
+	query := `
+		SELECT t.name as team_name, ` + percentiles("p.merged_at - p.created_at") + `
+		FROM pull_requests p
+		JOIN teams t ON t.id = p.team_id
+		WHERE ` + mergedConds(filter, &args) + `
+		GROUP BY t.name
+		ORDER BY t.name
+	`
+
+	var stats []*entity.TeamLatency
+	err := r.db.SelectContext(ctx, &stats, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.TeamLatency{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return stats, nil
+}
+
+// GetReviewLatency reports time-in-review per reviewer, from the assignment
+// to the merge of PRs merged in the window.
+func (r *StatisticsRepo) GetReviewLatency(ctx context.Context, filter entity.StatsFilter) ([]*entity.ReviewerLatency, error) {
+	const op = "pull_request_repo.GetReviewLatency"
+
+	var args statsArgs
+	query := `
+		SELECT u.id as user_id, u.name as username, ` + percentiles("p.merged_at - pr.assigned_at") + `
+		FROM pr_reviewers pr
+		JOIN pull_requests p ON p.id = pr.pull_request_id
+		JOIN teams t ON t.id = p.team_id
+		JOIN users u ON u.id = pr.user_id
+		WHERE ` + mergedConds(filter, &args) + `
+		GROUP BY u.id, u.name
+		ORDER BY u.name, u.id
+	`
+
+	var stats []*entity.ReviewerLatency
+	err := r.db.SelectContext(ctx, &stats, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.ReviewerLatency{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return stats, nil
+}
+
+// GetThroughput counts merged PRs per week, weeks without merges are left out.
+func (r *StatisticsRepo) GetThroughput(ctx context.Context, filter entity.StatsFilter) ([]*entity.WeeklyThroughput, error) {
+	const op = "pull_request_repo.GetThroughput"
+
+	var args statsArgs
+	query := `
+		SELECT to_char(date_trunc('week', p.merged_at), 'YYYY-MM-DD') as week, COUNT(*) as merged_count
+		FROM pull_requests p
+		JOIN teams t ON t.id = p.team_id
+		WHERE ` + mergedConds(filter, &args) + `
+		GROUP BY week
+		ORDER BY week
+	`
+
+	var stats []*entity.WeeklyThroughput
+	err := r.db.SelectContext(ctx, &stats, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.WeeklyThroughput{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return stats, nil
+}
+
+// GetMemberLoad lists every team member, or every member of the filter's team,
+// with the assignments on the team's PRs made in the window. Active days run
+// from joining the team or the window start to the window end or now, minus
+// the absences.
+func (r *StatisticsRepo) GetMemberLoad(ctx context.Context, filter entity.StatsFilter) ([]*entity.MemberLoad, error) {
+	const op = "pull_request_repo.GetMemberLoad"
+
+	var args statsArgs
+	// GREATEST and LEAST skip NULL, an open window end is now
+	from := args.add(utcOrNil(filter.From)) + "::timestamp"
+	to := args.add(utcOrNil(filter.To)) + "::timestamp"
+
+	teams := "TRUE"
+	if filter.TeamName != "" {
+		teams = "t.name = " + args.add(filter.TeamName)
+	}
+	// the team is narrowed above, the rollup rows follow the member's team
+	days := rollupConds(entity.StatsFilter{From: filter.From, To: filter.To}, &args)
+
+	query := `
+		SELECT t.name as team_name, u.id as user_id, u.name as username,
+		       (
+		           SELECT COALESCE(SUM(d.assignment_count), 0)
+		           FROM stats_daily_assignments d
+		           WHERE d.user_id = u.id AND d.team_id = t.id AND ` + days + `
+		       ) as assignment_count,
+		       GREATEST(0, EXTRACT(EPOCH FROM w.ends_at - w.starts_at) - COALESCE((
+		           SELECT SUM(GREATEST(0, EXTRACT(EPOCH FROM
+		               LEAST(COALESCE(a.ended_at, w.ends_at), w.ends_at) - GREATEST(a.started_at, w.starts_at)
+		           )))
+		           FROM user_absences a
+		           WHERE a.user_id = u.id
+		       ), 0)) / 86400 as active_days
+		FROM team_members tm
+		JOIN teams t ON t.id = tm.team_id
+		JOIN users u ON u.id = tm.user_id
+		CROSS JOIN LATERAL (
+		    SELECT GREATEST(tm.joined_at, ` + from + `) as starts_at,
+		           LEAST(now()::timestamp, ` + to + `) as ends_at
+		) w
+		WHERE ` + teams + `
+		ORDER BY t.name, u.name, u.id
+	`
+
+	var stats []*entity.MemberLoad
+	err := r.db.SelectContext(ctx, &stats, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.MemberLoad{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return stats, nil
+}
+
+func utcOrNil(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+// RebuildRollups refills the daily rollups from reviewer_assignments and
+// pull_requests. Writers wait on the table lock until the rebuild commits, so
+// it has to run in a transaction.
+func (r *StatisticsRepo) RebuildRollups(ctx context.Context) error {
+	const op = "pull_request_repo.RebuildRollups"
+
+	queries := []string{
+		`LOCK TABLE stats_daily_assignments, stats_daily_prs IN EXCLUSIVE MODE`,
+		`DELETE FROM stats_daily_assignments`,
+		`DELETE FROM stats_daily_prs`,
+		`
+		INSERT INTO stats_daily_assignments (day, team_id, user_id, assignment_count, weighted_load,
+		                                     assigned_count, reassigned_count, completed_count)
+		SELECT ra.assigned_at::date, p.team_id, ra.user_id,
+		       COUNT(*) FILTER (WHERE ra.unassigned_at IS NULL),
+		       COALESCE(SUM(` + sizeWeight("p.size") + `) FILTER (WHERE ra.unassigned_at IS NULL), 0),
+		       COUNT(*),
+		       COUNT(*) FILTER (WHERE ra.unassigned_at IS NOT NULL),
+		       COUNT(*) FILTER (WHERE ra.unassigned_at IS NULL AND p.status = 'MERGED')
+		FROM reviewer_assignments ra
+		JOIN pull_requests p ON p.id = ra.pull_request_id
+		GROUP BY 1, 2, 3
+		`,
+		`
+		INSERT INTO stats_daily_prs (day, team_id, created_count, merged_count)
+		SELECT p.created_at::date, p.team_id, COUNT(*), COUNT(*) FILTER (WHERE p.status = 'MERGED')
+		FROM pull_requests p
+		GROUP BY 1, 2
+		`,
+	}
+
+	db := r.getter.DefaultTrOrDB(ctx, r.db)
+	for _, query := range queries {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			return lib.Err(op, err)
+		}
+	}
+
+	return nil
+}
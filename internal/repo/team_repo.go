@@ -16,8 +16,19 @@ import (
 type TeamRepository interface {
 	Create(ctx context.Context, teamName string) (int, error)
 	GetByTeamName(ctx context.Context, teamName string) (*entity.Team, error)
+	List(ctx context.Context) ([]*entity.Team, error)
+	GetMemberships(ctx context.Context) ([]*entity.TeamMembership, error)
+	GetSizeTiers(ctx context.Context, teamID int) ([]*entity.SizeTier, error)
+	ReplaceSizeTiers(ctx context.Context, teamID int, tiers []*entity.SizeTier) error
+	GetRoutingRules(ctx context.Context, teamID int) ([]*entity.RoutingRule, error)
+	GetRoutingRule(ctx context.Context, ruleID int) (*entity.RoutingRule, error)
+	CreateRoutingRule(ctx context.Context, rule *entity.RoutingRule) (int, error)
+	UpdateRoutingRule(ctx context.Context, rule *entity.RoutingRule) error
+	DeleteRoutingRule(ctx context.Context, ruleID int) error
 }
 
+var _ TeamRepository = (*TeamRepo)(nil)
+
 type TeamRepo struct {
 	db     *sqlx.DB
 	getter *trm.CtxGetter
@@ -75,18 +86,274 @@ func (r *TeamRepo) GetByTeamName(ctx context.Context, teamName string) (*entity.
 	return &team, nil
 }
 
-func (r *TeamRepo) GetTeamNameByID(ctx context.Context, teamID int) (string, error) {
-	const op = "team_repository.GetTeamNameByID"
+func (r *TeamRepo) GetTeamsByUserID(ctx context.Context, userID string) ([]*entity.Team, error) {
+	const op = "team_repo.GetTeamsByUserID"
+
+	query := `
+		SELECT t.id, t.name, t.created_at
+		FROM teams t
+		JOIN team_members tm ON tm.team_id = t.id
+		WHERE tm.user_id = $1
+		ORDER BY tm.joined_at, t.id;
+	`
+
+	var teams []*entity.Team
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &teams, query, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.Team{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return teams, nil
+}
+
+// GetTeamsByUserIDs loads teams of several users in one query.
+func (r *TeamRepo) GetTeamsByUserIDs(ctx context.Context, userIDs []string) (map[string][]*entity.Team, error) {
+	const op = "team_repo.GetTeamsByUserIDs"
+
+	query := `
+		SELECT tm.user_id, t.id, t.name, t.created_at
+		FROM teams t
+		JOIN team_members tm ON tm.team_id = t.id
+		WHERE tm.user_id = ANY($1)
+		ORDER BY tm.user_id, tm.joined_at, t.id;
+	`
+
+	var rows []struct {
+		UserID string `db:"user_id"`
+		entity.Team
+	}
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &rows, query, pq.Array(userIDs))
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	teams := make(map[string][]*entity.Team, len(userIDs))
+	for _, row := range rows {
+		team := row.Team
+		teams[row.UserID] = append(teams[row.UserID], &team)
+	}
+
+	return teams, nil
+}
+
+func (r *TeamRepo) List(ctx context.Context) ([]*entity.Team, error) {
+	const op = "team_repo.List"
+
+	query := `
+		SELECT id, name, created_at
+		FROM teams
+		ORDER BY name;
+	`
+
+	var teams []*entity.Team
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &teams, query)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.Team{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return teams, nil
+}
+
+func (r *TeamRepo) GetMemberships(ctx context.Context) ([]*entity.TeamMembership, error) {
+	const op = "team_repo.GetMemberships"
+
+	query := `
+		SELECT tm.team_id, t.name AS team_name, tm.user_id
+		FROM team_members tm
+		JOIN teams t ON tm.team_id = t.id
+		ORDER BY t.name, tm.user_id;
+	`
+
+	var memberships []*entity.TeamMembership
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &memberships, query)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.TeamMembership{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return memberships, nil
+}
+
+// GetSizeTiers returns tiers from the smallest max_lines up, the unbounded
+// tier goes last.
+func (r *TeamRepo) GetSizeTiers(ctx context.Context, teamID int) ([]*entity.SizeTier, error) {
+	const op = "team_repo.GetSizeTiers"
+
+	query := `
+		SELECT team_id, max_lines, reviewers, senior_reviewers
+		FROM team_size_tiers
+		WHERE team_id = $1
+		ORDER BY max_lines ASC NULLS LAST;
+	`
+
+	var tiers []*entity.SizeTier
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &tiers, query, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.SizeTier{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return tiers, nil
+}
+
+// ReplaceSizeTiers must run in a transaction, it deletes the old tiers first.
+func (r *TeamRepo) ReplaceSizeTiers(ctx context.Context, teamID int, tiers []*entity.SizeTier) error {
+	const op = "team_repo.ReplaceSizeTiers"
+
+	db := r.getter.DefaultTrOrDB(ctx, r.db)
+
+	if _, err := db.ExecContext(ctx, `DELETE FROM team_size_tiers WHERE team_id = $1`, teamID); err != nil {
+		return lib.Err(op, err)
+	}
+
+	query := `
+		INSERT INTO team_size_tiers (team_id, max_lines, reviewers, senior_reviewers)
+		VALUES ($1, $2, $3, $4);
+	`
+	for _, t := range tiers {
+		if _, err := db.ExecContext(ctx, query, teamID, t.MaxLines, t.Reviewers, t.SeniorReviewers); err != nil {
+			return lib.Err(op, err)
+		}
+	}
+
+	return nil
+}
+
+const routingRuleColumns = `
+	r.id, r.team_id, r.label, r.action, r.target_team_id, t.name AS target_team_name,
+	r.reviewers, r.created_at
+`
+
+func (r *TeamRepo) GetRoutingRules(ctx context.Context, teamID int) ([]*entity.RoutingRule, error) {
+	const op = "team_repo.GetRoutingRules"
+
+	query := `
+		SELECT ` + routingRuleColumns + `
+		FROM routing_rules r
+		LEFT JOIN teams t ON t.id = r.target_team_id
+		WHERE r.team_id = $1
+		ORDER BY r.label, r.id;
+	`
 
-	var teamName string
-	query := `SELECT name FROM teams WHERE id = $1`
-	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &teamName, query, teamID)
+	var rules []*entity.RoutingRule
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &rules, query, teamID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return "", ErrNotFound
+			return []*entity.RoutingRule{}, nil
 		}
-		return "", lib.Err(op, err)
+		return nil, lib.Err(op, err)
 	}
 
-	return teamName, nil
+	return rules, nil
+}
+
+func (r *TeamRepo) GetRoutingRule(ctx context.Context, ruleID int) (*entity.RoutingRule, error) {
+	const op = "team_repo.GetRoutingRule"
+
+	query := `
+		SELECT ` + routingRuleColumns + `
+		FROM routing_rules r
+		LEFT JOIN teams t ON t.id = r.target_team_id
+		WHERE r.id = $1;
+	`
+
+	var rule entity.RoutingRule
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &rule, query, ruleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return &rule, nil
+}
+
+func (r *TeamRepo) CreateRoutingRule(ctx context.Context, rule *entity.RoutingRule) (int, error) {
+	const op = "team_repo.CreateRoutingRule"
+
+	query := `
+		INSERT INTO routing_rules (team_id, label, action, target_team_id, reviewers)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id;
+	`
+
+	var ruleID int
+	err := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowContext(ctx, query,
+		rule.TeamID, rule.Label, rule.Action, rule.TargetTeamID, rule.Reviewers,
+	).Scan(&ruleID)
+	if err != nil {
+		return 0, routingRuleErr(op, err)
+	}
+
+	return ruleID, nil
+}
+
+func (r *TeamRepo) UpdateRoutingRule(ctx context.Context, rule *entity.RoutingRule) error {
+	const op = "team_repo.UpdateRoutingRule"
+
+	query := `
+		UPDATE routing_rules
+		SET label = $2, action = $3, target_team_id = $4, reviewers = $5
+		WHERE id = $1;
+	`
+
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query,
+		rule.ID, rule.Label, rule.Action, rule.TargetTeamID, rule.Reviewers,
+	)
+	if err != nil {
+		return routingRuleErr(op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return lib.Err(op, err)
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *TeamRepo) DeleteRoutingRule(ctx context.Context, ruleID int) error {
+	const op = "team_repo.DeleteRoutingRule"
+
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `DELETE FROM routing_rules WHERE id = $1`, ruleID)
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return lib.Err(op, err)
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func routingRuleErr(op string, err error) error {
+	pgErr := &pq.Error{}
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolationCode:
+			return ErrRuleExists
+		case foreignKeyViolationCode:
+			return ErrNotFound
+		}
+	}
+	return lib.Err(op, err)
 }
@@ -4,19 +4,29 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 
 	"railgorail/avito/internal/entity"
 	"railgorail/avito/internal/lib"
 
 	trm "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type UserRepository interface {
 	Save(ctx context.Context, user *entity.User) (string, error)
 	GetById(ctx context.Context, userID string) (*entity.User, error)
-	GetUsersInTeam(ctx context.Context, teamID int) ([]*entity.User, error)
+	GetUsersInTeam(ctx context.Context, teamName string) ([]*entity.User, error)
+	AddToTeam(ctx context.Context, userID string, teamID int) error
+	RemoveFromTeam(ctx context.Context, userID string, teamID int) error
+	GetAll(ctx context.Context) ([]*entity.User, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) error
+	List(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error)
+	UpdateName(ctx context.Context, userID, name string) error
+	SetIsSenior(ctx context.Context, userID string, isSenior bool) error
+	Delete(ctx context.Context, userID string) error
 }
 
 type UserRepo struct {
@@ -35,11 +45,10 @@ func (r *UserRepo) Save(ctx context.Context, user *entity.User) (string, error)
 	const op = "user_repo.Save"
 
 	query := `
-		INSERT INTO users (id, name, team_id, is_active, created_at)
-		VALUES ($1, $2, $3, $4, NOW())
+		INSERT INTO users (id, name, is_active, created_at)
+		VALUES ($1, $2, $3, NOW())
 		ON CONFLICT (id) DO UPDATE SET
 			name = EXCLUDED.name,
-			team_id = EXCLUDED.team_id,
 			is_active = EXCLUDED.is_active
 		RETURNING id;
 	`
@@ -47,7 +56,7 @@ func (r *UserRepo) Save(ctx context.Context, user *entity.User) (string, error)
 	var userID string
 	err := r.getter.
 		DefaultTrOrDB(ctx, r.db).
-		QueryRowContext(ctx, query, user.ID, user.Name, user.TeamID, user.IsActive).Scan(&userID)
+		QueryRowContext(ctx, query, user.ID, user.Name, user.IsActive).Scan(&userID)
 	if err != nil {
 		return "", lib.Err(op, err)
 	}
@@ -59,7 +68,7 @@ func (r *UserRepo) GetById(ctx context.Context, userID string) (*entity.User, er
 	const op = "user_repo.GetById"
 
 	query := `
-		SELECT id, name, team_id, is_active, created_at
+		SELECT id, name, is_active, is_senior, created_at
 		FROM users
 		WHERE id = $1;
 	`
@@ -80,10 +89,12 @@ func (r *UserRepo) GetUsersInTeam(ctx context.Context, teamName string) ([]*enti
 	const op = "user_repo.GetUsersInTeam"
 
 	query := `
-		SELECT u.id, u.name, u.team_id, u.is_active, u.created_at
+		SELECT u.id, u.name, u.is_active, u.is_senior, u.created_at
 		FROM users u
-		JOIN teams t ON u.team_id = t.id
-		WHERE t.name = $1;
+		JOIN team_members tm ON tm.user_id = u.id
+		JOIN teams t ON tm.team_id = t.id
+		WHERE t.name = $1
+		ORDER BY tm.joined_at, u.id;
 	`
 
 	var users []*entity.User
@@ -104,8 +115,8 @@ func (r *UserRepo) GetActiveUsersIDInTeam(ctx context.Context, teamID int) ([]st
 	query := `
 		SELECT u.id
 		FROM users u
-		JOIN teams t ON u.team_id = t.id
-		WHERE t.id = $1 AND u.is_active = TRUE;
+		JOIN team_members tm ON tm.user_id = u.id
+		WHERE tm.team_id = $1 AND u.is_active = TRUE;
 	`
 
 	var users []string
@@ -120,16 +131,216 @@ func (r *UserRepo) GetActiveUsersIDInTeam(ctx context.Context, teamID int) ([]st
 	return users, nil
 }
 
+func (r *UserRepo) GetActiveSeniorsIDInTeam(ctx context.Context, teamID int) ([]string, error) {
+	const op = "user_repo.GetActiveSeniorsIDInTeam"
+
+	query := `
+		SELECT u.id
+		FROM users u
+		JOIN team_members tm ON tm.user_id = u.id
+		WHERE tm.team_id = $1 AND u.is_active = TRUE AND u.is_senior = TRUE;
+	`
+
+	var users []string
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &users, query, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []string{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return users, nil
+}
+
+func (r *UserRepo) AddToTeam(ctx context.Context, userID string, teamID int) error {
+	const op = "user_repo.AddToTeam"
+
+	query := `
+		INSERT INTO team_members (team_id, user_id, joined_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (team_id, user_id) DO NOTHING;
+	`
+
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, teamID, userID)
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	return nil
+}
+
+func (r *UserRepo) RemoveFromTeam(ctx context.Context, userID string, teamID int) error {
+	const op = "user_repo.RemoveFromTeam"
+
+	query := `DELETE FROM team_members WHERE team_id = $1 AND user_id = $2`
+
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, teamID, userID)
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepo) GetAll(ctx context.Context) ([]*entity.User, error) {
+	const op = "user_repo.GetAll"
+
+	query := `
+		SELECT id, name, is_active, is_senior, created_at
+		FROM users
+		ORDER BY id;
+	`
+
+	var users []*entity.User
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &users, query)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.User{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return users, nil
+}
+
 func (r *UserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
 	const op = "user_repo.SetIsActive"
 
 	query := `UPDATE users SET is_active = $1 WHERE id = $2`
 
-	res, err := r.db.ExecContext(ctx, query, isActive, userID)
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, isActive, userID)
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
 	if err != nil {
 		return lib.Err(op, err)
 	}
 
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepo) List(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error) {
+	const op = "user_repo.List"
+
+	var (
+		conds []string
+		args  []any
+	)
+	if filter.TeamName != "" {
+		args = append(args, filter.TeamName)
+		conds = append(conds, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM team_members tm
+			JOIN teams t ON tm.team_id = t.id
+			WHERE tm.user_id = u.id AND t.name = $%d
+		)`, len(args)))
+	}
+	if filter.IsActive != nil {
+		args = append(args, *filter.IsActive)
+		conds = append(conds, fmt.Sprintf("u.is_active = $%d", len(args)))
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(`
+		SELECT u.id, u.name, u.is_active, u.is_senior, u.created_at
+		FROM users u
+		%s
+		ORDER BY u.id
+		LIMIT $%d OFFSET $%d;
+	`, where, len(args)-1, len(args))
+
+	var users []*entity.User
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &users, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []*entity.User{}, nil
+		}
+		return nil, lib.Err(op, err)
+	}
+
+	return users, nil
+}
+
+func (r *UserRepo) UpdateName(ctx context.Context, userID, name string) error {
+	const op = "user_repo.UpdateName"
+
+	query := `UPDATE users SET name = $1 WHERE id = $2`
+
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, name, userID)
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepo) SetIsSenior(ctx context.Context, userID string, isSenior bool) error {
+	const op = "user_repo.SetIsSenior"
+
+	query := `UPDATE users SET is_senior = $1 WHERE id = $2`
+
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, isSenior, userID)
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return lib.Err(op, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepo) Delete(ctx context.Context, userID string) error {
+	const op = "user_repo.Delete"
+
+	query := `DELETE FROM users WHERE id = $1`
+
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, query, userID)
+	if err != nil {
+		pgErr := &pq.Error{}
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == foreignKeyViolationCode && pgErr.Constraint == prAuthorConstraint {
+				return ErrUserIsAuthor
+			}
+		}
+		return lib.Err(op, err)
+	}
+
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
 		return lib.Err(op, err)
@@ -15,11 +15,16 @@ import (
 )
 
 type Server struct {
-	httpServer *http.Server
-	log        *slog.Logger
+	httpServer    *http.Server
+	log           *slog.Logger
+	drain         func()
+	shutdownDelay time.Duration
 }
 
-func New(handler http.Handler, log *slog.Logger, cfg *config.Config) *Server {
+// New builds the server. drain is called on a stop signal, shutdownDelay
+// before connections start draining, so load balancers see the failing
+// readiness probe first.
+func New(handler http.Handler, log *slog.Logger, cfg *config.Config, drain func()) *Server {
 	return &Server{
 		httpServer: &http.Server{
 			Addr:         cfg.HTTPServer.Address,
@@ -28,7 +33,9 @@ func New(handler http.Handler, log *slog.Logger, cfg *config.Config) *Server {
 			WriteTimeout: cfg.HTTPServer.WriteTimeout,
 			IdleTimeout:  cfg.HTTPServer.IdleTimeout,
 		},
-		log: log,
+		log:           log,
+		drain:         drain,
+		shutdownDelay: cfg.HTTPServer.ShutdownDelay,
 	}
 }
 
@@ -46,6 +53,16 @@ func (s *Server) Run(shutdownTimeout time.Duration) {
 	select {
 	case sig := <-sigCh:
 		s.log.Info("stopping service", slog.String("signal", sig.String()))
+		s.drain()
+		if s.shutdownDelay > 0 {
+			s.log.Info("readiness off, waiting before shutdown", slog.Duration("delay", s.shutdownDelay))
+			select {
+			case <-time.After(s.shutdownDelay):
+			case sig := <-sigCh:
+				s.log.Info("second signal, shutting down now", slog.String("signal", sig.String()))
+			}
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 		defer cancel()
 
@@ -0,0 +1,159 @@
+package directory
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service/team"
+	"railgorail/avito/internal/tracing"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=DirectoryProvider
+type DirectoryProvider interface {
+	Fetch(ctx context.Context) (*entity.Directory, error)
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=TeamSyncer
+type TeamSyncer interface {
+	Sync(ctx context.Context, roster *dto.Roster, opts team.SyncOptions) (*dto.SyncResponse, error)
+}
+
+// DirectoryService keeps teams in line with groups of the company directory.
+// Only mapped groups are managed: other teams and their members are left alone,
+// and users who are gone from the directory are deactivated.
+type DirectoryService struct {
+	log              *slog.Logger
+	provider         DirectoryProvider
+	teamSyncer       TeamSyncer
+	groupMapping     map[string]string
+	maxDeactivations int
+}
+
+// NewDirectoryService takes groupMapping from directory group name to team name.
+// A run that would deactivate more than maxDeactivations users is aborted,
+// 0 turns the limit off.
+func NewDirectoryService(log *slog.Logger, provider DirectoryProvider, teamSyncer TeamSyncer, groupMapping map[string]string, maxDeactivations int) *DirectoryService {
+	return &DirectoryService{
+		log:              log,
+		provider:         provider,
+		teamSyncer:       teamSyncer,
+		groupMapping:     groupMapping,
+		maxDeactivations: maxDeactivations,
+	}
+}
+
+func (s *DirectoryService) Sync(ctx context.Context) (*dto.SyncResponse, error) {
+	ctx, span := tracing.Start(ctx, "DirectoryService.Sync")
+	defer span.End()
+
+	dir, err := s.provider.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roster, known := s.buildRoster(dir)
+
+	// an empty answer is far more likely a broken filter or connection than
+	// an empty company, syncing it would deactivate everybody
+	if len(known) == 0 || !hasMembers(roster) {
+		return nil, repo.ErrEmptyDirectory
+	}
+
+	// people still in the directory keep is_active as set through the API,
+	// a vacation should not be undone by the next sync
+	return s.teamSyncer.Sync(ctx, roster, team.SyncOptions{
+		OnlyListedTeams:  true,
+		KeepUsers:        known,
+		KeepIsActive:     true,
+		MaxDeactivations: s.maxDeactivations,
+	})
+}
+
+func hasMembers(roster *dto.Roster) bool {
+	for _, t := range roster.Teams {
+		if len(t.Members) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Run syncs right away and then every interval until ctx is done. Failed runs
+// are logged and retried on the next tick.
+func (s *DirectoryService) Run(ctx context.Context, interval time.Duration) {
+	const op = "directory_service.Run"
+	log := s.log.With(slog.String("op", op))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := s.Sync(ctx)
+		if err != nil {
+			log.Error("directory sync failed", sl.Err(err))
+		} else {
+			log.Info("directory synced",
+				slog.Int("changes", len(resp.Changes)),
+				slog.Int("reassigned_reviews", len(resp.ReassignedReviews)),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *DirectoryService) buildRoster(dir *entity.Directory) (*dto.Roster, []string) {
+	users := make(map[string]entity.DirectoryUser, len(dir.Users))
+	known := make([]string, 0, len(dir.Users))
+	for _, u := range dir.Users {
+		users[u.ID] = u
+		known = append(known, u.ID)
+	}
+
+	// several groups may feed the same team
+	members := make(map[string][]string)
+	for _, g := range dir.Groups {
+		teamName, ok := s.groupMapping[g.Name]
+		if !ok {
+			continue
+		}
+		if _, ok := members[teamName]; !ok {
+			members[teamName] = []string{}
+		}
+		for _, id := range g.MemberIDs {
+			if _, ok := users[id]; ok && !slices.Contains(members[teamName], id) {
+				members[teamName] = append(members[teamName], id)
+			}
+		}
+	}
+
+	roster := &dto.Roster{Teams: make([]dto.RosterTeam, 0, len(members))}
+	for teamName, ids := range members {
+		slices.Sort(ids)
+		t := dto.RosterTeam{TeamName: teamName, Members: make([]dto.TeamMember, 0, len(ids))}
+		for _, id := range ids {
+			t.Members = append(t.Members, dto.TeamMember{
+				UserID:   id,
+				Username: users[id].Name,
+				IsActive: true,
+			})
+		}
+		roster.Teams = append(roster.Teams, t)
+	}
+	slices.SortFunc(roster.Teams, func(a, b dto.RosterTeam) int {
+		return strings.Compare(a.TeamName, b.TeamName)
+	})
+
+	return roster, known
+}
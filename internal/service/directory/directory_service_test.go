@@ -0,0 +1,119 @@
+package directory_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service/directory"
+	"railgorail/avito/internal/service/mocks"
+	"railgorail/avito/internal/service/team"
+	"railgorail/avito/internal/transport/http/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var discardLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestDirectoryService_Sync_MapsGroupsToTeams(t *testing.T) {
+	ctx := context.Background()
+	mockProvider := mocks.NewDirectoryProvider(t)
+	mockSyncer := mocks.NewTeamSyncer(t)
+
+	mockProvider.On("Fetch", ctx).Return(&entity.Directory{
+		Users: []entity.DirectoryUser{
+			{ID: "u1", Name: "Alice"},
+			{ID: "u2", Name: "Bob"},
+			{ID: "u3", Name: "Carol"},
+		},
+		Groups: []entity.DirectoryGroup{
+			{Name: "backend-devs", MemberIDs: []string{"u2", "u1"}},
+			{Name: "backend-leads", MemberIDs: []string{"u1"}},
+			{Name: "sales", MemberIDs: []string{"u3"}},
+			{Name: "frontend-devs", MemberIDs: []string{"u9"}},
+		},
+	}, nil).Once()
+
+	expectedRoster := &dto.Roster{Teams: []dto.RosterTeam{
+		{TeamName: "backend", Members: []dto.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: true},
+		}},
+		{TeamName: "frontend", Members: []dto.TeamMember{}},
+	}}
+	expectedOpts := team.SyncOptions{
+		OnlyListedTeams:  true,
+		KeepUsers:        []string{"u1", "u2", "u3"},
+		KeepIsActive:     true,
+		MaxDeactivations: 5,
+	}
+	resp := &dto.SyncResponse{Changes: []dto.SyncChange{}}
+	mockSyncer.On("Sync", ctx, expectedRoster, expectedOpts).Return(resp, nil).Once()
+
+	svc := directory.NewDirectoryService(discardLog, mockProvider, mockSyncer, map[string]string{
+		"backend-devs":  "backend",
+		"backend-leads": "backend",
+		"frontend-devs": "frontend",
+	}, 5)
+	result, e := svc.Sync(ctx)
+
+	assert.NoError(t, e)
+	assert.Equal(t, resp, result)
+}
+
+func TestDirectoryService_Sync_FetchError(t *testing.T) {
+	ctx := context.Background()
+	mockProvider := mocks.NewDirectoryProvider(t)
+	mockSyncer := mocks.NewTeamSyncer(t)
+	fetchErr := errors.New("ldap unavailable")
+
+	mockProvider.On("Fetch", ctx).Return(nil, fetchErr).Once()
+
+	svc := directory.NewDirectoryService(discardLog, mockProvider, mockSyncer, map[string]string{"devs": "backend"}, 5)
+	result, e := svc.Sync(ctx)
+
+	assert.ErrorIs(t, e, fetchErr)
+	assert.Nil(t, result)
+	mockSyncer.AssertNotCalled(t, "Sync", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDirectoryService_Sync_RefusesEmptyDirectory(t *testing.T) {
+	tests := []struct {
+		name string
+		dir  *entity.Directory
+	}{
+		{
+			name: "no users",
+			dir:  &entity.Directory{},
+		},
+		{
+			name: "mapped groups match nobody",
+			dir: &entity.Directory{
+				Users:  []entity.DirectoryUser{{ID: "u1", Name: "Alice"}},
+				Groups: []entity.DirectoryGroup{{Name: "sales", MemberIDs: []string{"u1"}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockProvider := mocks.NewDirectoryProvider(t)
+			mockSyncer := mocks.NewTeamSyncer(t)
+
+			mockProvider.On("Fetch", ctx).Return(tt.dir, nil).Once()
+
+			svc := directory.NewDirectoryService(discardLog, mockProvider, mockSyncer, map[string]string{"devs": "backend"}, 5)
+			result, e := svc.Sync(ctx)
+
+			assert.ErrorIs(t, e, repo.ErrEmptyDirectory)
+			assert.Nil(t, result)
+			mockSyncer.AssertNotCalled(t, "Sync", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+// Error is a failure the client caused or can act on. Code, Status and
+// Message are safe to show, Details names the objects involved, e.g. which
+// PR or reviewer. Err is the cause, kept for logs and errors.Is.
+type Error struct {
+	Code    string
+	Status  int
+	Message string
+	Details map[string]any
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return e.Message
+	}
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+type domainError struct {
+	err    error
+	code   string
+	status int
+}
+
+// domainErrors is the single place repo errors get their code and status.
+var domainErrors = []domainError{
+	{repo.ErrNotFound, dto.ErrCodeNotFound, http.StatusNotFound},
+	{repo.ErrTeamExists, dto.ErrCodeTeamExists, http.StatusBadRequest},
+	{repo.ErrPRExists, dto.ErrCodePRExists, http.StatusConflict},
+	{repo.ErrPRMerged, dto.ErrCodePRMerged, http.StatusConflict},
+	{repo.ErrNotAssigned, dto.ErrCodeNotAssigned, http.StatusConflict},
+	{repo.ErrNoCandidate, dto.ErrCodeNoCandidate, http.StatusConflict},
+	{repo.ErrHasOpenReviews, dto.ErrCodeHasOpenReviews, http.StatusConflict},
+	{repo.ErrUserIsAuthor, dto.ErrCodeUserIsAuthor, http.StatusConflict},
+	{repo.ErrTeamRequired, dto.ErrCodeTeamRequired, http.StatusBadRequest},
+	{repo.ErrNotTeamMember, dto.ErrCodeNotTeamMember, http.StatusBadRequest},
+	{repo.ErrInvalidRoster, dto.ErrValidationErr, http.StatusBadRequest},
+	{repo.ErrInvalidSizeTiers, dto.ErrValidationErr, http.StatusBadRequest},
+	{repo.ErrInvalidRule, dto.ErrValidationErr, http.StatusBadRequest},
+	{repo.ErrRuleExists, dto.ErrCodeRuleExists, http.StatusConflict},
+	{repo.ErrInvalidParent, dto.ErrValidationErr, http.StatusBadRequest},
+	{repo.ErrParentNotMerged, dto.ErrCodeParentNotMerged, http.StatusConflict},
+	{repo.ErrTooManyDeactivations, dto.ErrCodeTooManyDeactivations, http.StatusConflict},
+	{repo.ErrEmptyDirectory, dto.ErrCodeEmptyDirectory, http.StatusConflict},
+}
+
+// AsError finds the domain error in err's chain. A bare repo error gets the
+// text of its sentinel as message, never the wrapped chain with op names.
+// It returns nil for internal errors.
+func AsError(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	for _, d := range domainErrors {
+		if errors.Is(err, d.err) {
+			return &Error{Code: d.code, Status: d.status, Message: d.err.Error(), Err: err}
+		}
+	}
+	return nil
+}
+
+// Fail builds the domain error for a repo sentinel. reason, when set, is
+// appended to the sentinel text; details are key-value pairs.
+func Fail(sentinel error, reason string, details ...any) error {
+	e := AsError(sentinel)
+	if e == nil {
+		panic(fmt.Sprintf("service.Fail: %v is not a domain error", sentinel))
+	}
+	if reason != "" {
+		e.Message += ": " + reason
+		e.Err = fmt.Errorf("%w: %s", sentinel, reason)
+	}
+	return withDetails(e, details)
+}
+
+// With attaches key-value details to a domain error in err. Other errors
+// are returned as is.
+func With(err error, details ...any) error {
+	e := AsError(err)
+	if e == nil {
+		return err
+	}
+	return withDetails(e, details)
+}
+
+func withDetails(e *Error, details []any) *Error {
+	if len(details) == 0 {
+		return e
+	}
+	merged := make(map[string]any, len(e.Details)+len(details)/2)
+	for k, v := range e.Details {
+		merged[k] = v
+	}
+	for i := 0; i+1 < len(details); i += 2 {
+		key, ok := details[i].(string)
+		if !ok {
+			continue
+		}
+		if _, set := merged[key]; !set {
+			merged[key] = details[i+1]
+		}
+	}
+	copied := *e
+	copied.Details = merged
+	return &copied
+}
@@ -0,0 +1,86 @@
+package service_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"railgorail/avito/internal/lib"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/transport/http/dto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsError_HidesWrapping(t *testing.T) {
+	err := lib.Err("pull_request_repo.ReassignReviewer", repo.ErrNotAssigned)
+
+	e := service.AsError(err)
+
+	if assert.NotNil(t, e) {
+		assert.Equal(t, dto.ErrCodeNotAssigned, e.Code)
+		assert.Equal(t, http.StatusConflict, e.Status)
+		assert.Equal(t, "reviewer is not assigned to this PR", e.Message)
+		assert.ErrorIs(t, e, repo.ErrNotAssigned)
+	}
+}
+
+func TestAsError_SyncGuards(t *testing.T) {
+	tests := []struct {
+		err      error
+		wantCode string
+	}{
+		{err: repo.ErrTooManyDeactivations, wantCode: dto.ErrCodeTooManyDeactivations},
+		{err: repo.ErrEmptyDirectory, wantCode: dto.ErrCodeEmptyDirectory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantCode, func(t *testing.T) {
+			e := service.AsError(lib.Err("team_service.Sync", tt.err))
+
+			if assert.NotNil(t, e) {
+				assert.Equal(t, tt.wantCode, e.Code)
+				assert.Equal(t, http.StatusConflict, e.Status)
+				assert.Equal(t, tt.err.Error(), e.Message)
+			}
+		})
+	}
+}
+
+func TestAsError_Internal(t *testing.T) {
+	assert.Nil(t, service.AsError(errors.New("connection reset")))
+}
+
+func TestFail_ReasonAndDetails(t *testing.T) {
+	err := service.Fail(repo.ErrInvalidRule, "label is empty", "team_name", "backend")
+
+	e := service.AsError(err)
+
+	if assert.NotNil(t, e) {
+		assert.Equal(t, dto.ErrValidationErr, e.Code)
+		assert.Equal(t, http.StatusBadRequest, e.Status)
+		assert.Equal(t, "invalid routing rule: label is empty", e.Message)
+		assert.Equal(t, map[string]any{"team_name": "backend"}, e.Details)
+		assert.ErrorIs(t, err, repo.ErrInvalidRule)
+	}
+}
+
+func TestWith_KeepsInnerDetails(t *testing.T) {
+	inner := service.Fail(repo.ErrParentNotMerged, "", "parent_id", "pr-1")
+	wrapped := fmt.Errorf("merge: %w", inner)
+
+	e := service.AsError(service.With(wrapped, "pull_request_id", "pr-2", "parent_id", "ignored"))
+
+	if assert.NotNil(t, e) {
+		assert.Equal(t, map[string]any{"parent_id": "pr-1", "pull_request_id": "pr-2"}, e.Details)
+		assert.Equal(t, "parent PR is not merged yet", e.Message)
+	}
+}
+
+func TestWith_InternalUnchanged(t *testing.T) {
+	err := errors.New("connection reset")
+
+	assert.Same(t, err, service.With(err, "user_id", "u1"))
+}
@@ -0,0 +1,110 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=DBChecker
+type DBChecker interface {
+	Ping(ctx context.Context) error
+	MigrationVersion(ctx context.Context) (uint, bool, error)
+}
+
+const (
+	CheckPostgres   = "postgres"
+	CheckMigrations = "migrations"
+	CheckServer     = "server"
+)
+
+// HealthService answers the readiness probe. Once Drain is called it
+// reports not ready without touching the dependencies. The probe is public,
+// so failed checks carry a fixed message and the cause is only logged.
+type HealthService struct {
+	log           *slog.Logger
+	db            DBChecker
+	latestVersion uint
+	timeout       time.Duration
+	draining      atomic.Bool
+}
+
+func NewHealthService(log *slog.Logger, db DBChecker, latestVersion uint, timeout time.Duration) *HealthService {
+	return &HealthService{
+		log:           log,
+		db:            db,
+		latestVersion: latestVersion,
+		timeout:       timeout,
+	}
+}
+
+// Drain turns readiness off for good, the server calls it before it stops
+// accepting connections.
+func (s *HealthService) Drain() {
+	s.draining.Store(true)
+}
+
+// Ready checks every dependency within the timeout. The database may be
+// ahead of latestVersion during a rolling deploy, that still counts as ready.
+func (s *HealthService) Ready(ctx context.Context) *dto.ReadinessResponse {
+	resp := &dto.ReadinessResponse{
+		Status: dto.HealthUp,
+		Checks: map[string]dto.DependencyHealth{},
+	}
+
+	if s.draining.Load() {
+		resp.Status = dto.HealthDown
+		resp.Checks[CheckServer] = dto.DependencyHealth{Status: dto.HealthDown, Error: "shutting down"}
+		return resp
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp.Checks[CheckPostgres] = s.checkPostgres(ctx)
+	resp.Checks[CheckMigrations] = s.checkMigrations(ctx)
+
+	for _, check := range resp.Checks {
+		if check.Status != dto.HealthUp {
+			resp.Status = dto.HealthDown
+		}
+	}
+
+	return resp
+}
+
+func (s *HealthService) checkPostgres(ctx context.Context) dto.DependencyHealth {
+	const op = "health_service.checkPostgres"
+
+	if err := s.db.Ping(ctx); err != nil {
+		s.log.Error("postgres is unreachable", slog.String("op", op), sl.Err(err))
+		return dto.DependencyHealth{Status: dto.HealthDown, Error: "database is unreachable"}
+	}
+	return dto.DependencyHealth{Status: dto.HealthUp}
+}
+
+func (s *HealthService) checkMigrations(ctx context.Context) dto.DependencyHealth {
+	const op = "health_service.checkMigrations"
+
+	version, dirty, err := s.db.MigrationVersion(ctx)
+	if err != nil {
+		s.log.Error("failed to read migration version", slog.String("op", op), sl.Err(err))
+		return dto.DependencyHealth{Status: dto.HealthDown, Error: "migration version is unavailable"}
+	}
+
+	check := dto.DependencyHealth{Status: dto.HealthUp, Version: version}
+	switch {
+	case dirty:
+		check.Status = dto.HealthDown
+		check.Error = fmt.Sprintf("migration %d is dirty", version)
+	case version < s.latestVersion:
+		check.Status = dto.HealthDown
+		check.Error = fmt.Sprintf("at version %d, expected %d", version, s.latestVersion)
+	}
+	return check
+}
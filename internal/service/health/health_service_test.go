@@ -0,0 +1,84 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"railgorail/avito/internal/service/health"
+	"railgorail/avito/internal/service/mocks"
+	"railgorail/avito/internal/transport/http/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var discardLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestHealthService_Ready_Up(t *testing.T) {
+	mockDB := mocks.NewDBChecker(t)
+	mockDB.On("Ping", mock.Anything).Return(nil).Once()
+	mockDB.On("MigrationVersion", mock.Anything).Return(uint(13), false, nil).Once()
+
+	svc := health.NewHealthService(discardLog, mockDB, 12, time.Second)
+	resp := svc.Ready(context.Background())
+
+	assert.Equal(t, dto.HealthUp, resp.Status)
+	assert.Equal(t, dto.HealthUp, resp.Checks[health.CheckPostgres].Status)
+	assert.Equal(t, dto.DependencyHealth{Status: dto.HealthUp, Version: 13}, resp.Checks[health.CheckMigrations])
+}
+
+func TestHealthService_Ready_PostgresDown(t *testing.T) {
+	mockDB := mocks.NewDBChecker(t)
+	mockDB.On("Ping", mock.Anything).Return(errors.New("connection refused")).Once()
+	mockDB.On("MigrationVersion", mock.Anything).Return(uint(0), false, errors.New("connection refused")).Once()
+
+	svc := health.NewHealthService(discardLog, mockDB, 12, time.Second)
+	resp := svc.Ready(context.Background())
+
+	assert.Equal(t, dto.HealthDown, resp.Status)
+	assert.Equal(t, dto.HealthDown, resp.Checks[health.CheckPostgres].Status)
+	// the cause is logged, the probe only says which check failed
+	assert.Equal(t, "database is unreachable", resp.Checks[health.CheckPostgres].Error)
+	assert.Equal(t, "migration version is unavailable", resp.Checks[health.CheckMigrations].Error)
+}
+
+func TestHealthService_Ready_MigrationsBehind(t *testing.T) {
+	mockDB := mocks.NewDBChecker(t)
+	mockDB.On("Ping", mock.Anything).Return(nil).Once()
+	mockDB.On("MigrationVersion", mock.Anything).Return(uint(11), false, nil).Once()
+
+	svc := health.NewHealthService(discardLog, mockDB, 12, time.Second)
+	resp := svc.Ready(context.Background())
+
+	assert.Equal(t, dto.HealthDown, resp.Status)
+	assert.Equal(t, dto.HealthUp, resp.Checks[health.CheckPostgres].Status)
+	assert.Equal(t, "at version 11, expected 12", resp.Checks[health.CheckMigrations].Error)
+}
+
+func TestHealthService_Ready_MigrationDirty(t *testing.T) {
+	mockDB := mocks.NewDBChecker(t)
+	mockDB.On("Ping", mock.Anything).Return(nil).Once()
+	mockDB.On("MigrationVersion", mock.Anything).Return(uint(12), true, nil).Once()
+
+	svc := health.NewHealthService(discardLog, mockDB, 12, time.Second)
+	resp := svc.Ready(context.Background())
+
+	assert.Equal(t, dto.HealthDown, resp.Status)
+	assert.Equal(t, "migration 12 is dirty", resp.Checks[health.CheckMigrations].Error)
+}
+
+func TestHealthService_Ready_Draining(t *testing.T) {
+	mockDB := mocks.NewDBChecker(t)
+
+	svc := health.NewHealthService(discardLog, mockDB, 12, time.Second)
+	svc.Drain()
+	resp := svc.Ready(context.Background())
+
+	assert.Equal(t, dto.HealthDown, resp.Status)
+	assert.Equal(t, dto.HealthDown, resp.Checks[health.CheckServer].Status)
+	mockDB.AssertNotCalled(t, "Ping", mock.Anything)
+}
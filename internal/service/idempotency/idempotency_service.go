@@ -0,0 +1,63 @@
+package idempotency
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/tracing"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=ExpiredKeyDeleter
+type ExpiredKeyDeleter interface {
+	DeleteExpired(ctx context.Context, ttl time.Duration) (int64, error)
+}
+
+// IdempotencyService purges Idempotency-Key records that are no longer
+// replayed, the table would otherwise keep every key ever sent.
+type IdempotencyService struct {
+	log  *slog.Logger
+	keys ExpiredKeyDeleter
+	ttl  time.Duration
+}
+
+func NewIdempotencyService(log *slog.Logger, keys ExpiredKeyDeleter, ttl time.Duration) *IdempotencyService {
+	return &IdempotencyService{
+		log:  log,
+		keys: keys,
+		ttl:  ttl,
+	}
+}
+
+// Purge removes keys older than the ttl and returns how many were removed.
+func (s *IdempotencyService) Purge(ctx context.Context) (int64, error) {
+	ctx, span := tracing.Start(ctx, "IdempotencyService.Purge")
+	defer span.End()
+
+	return s.keys.DeleteExpired(ctx, s.ttl)
+}
+
+// Run purges expired keys right away and then every interval until ctx is done.
+func (s *IdempotencyService) Run(ctx context.Context, interval time.Duration) {
+	const op = "idempotency_service.Run"
+	log := s.log.With(slog.String("op", op))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.Purge(ctx)
+		if err != nil {
+			log.Error("failed to purge idempotency keys", sl.Err(err))
+		} else if deleted > 0 {
+			log.Info("expired idempotency keys purged", slog.Int64("deleted", deleted))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package idempotency_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"railgorail/avito/internal/service/idempotency"
+	"railgorail/avito/internal/service/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var discardLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestIdempotencyService_Purge_UsesTTL(t *testing.T) {
+	ctx := context.Background()
+	mockKeys := mocks.NewExpiredKeyDeleter(t)
+
+	mockKeys.On("DeleteExpired", ctx, 24*time.Hour).Return(int64(3), nil).Once()
+
+	svc := idempotency.NewIdempotencyService(discardLog, mockKeys, 24*time.Hour)
+	deleted, err := svc.Purge(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "railgorail/avito/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DirectoryProvider is an autogenerated mock type for the DirectoryProvider type
+type DirectoryProvider struct {
+	mock.Mock
+}
+
+// Fetch provides a mock function with given fields: ctx
+func (_m *DirectoryProvider) Fetch(ctx context.Context) (*entity.Directory, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fetch")
+	}
+
+	var r0 *entity.Directory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*entity.Directory, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *entity.Directory); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Directory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewDirectoryProvider creates a new instance of DirectoryProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDirectoryProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DirectoryProvider {
+	mock := &DirectoryProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "railgorail/avito/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PendingPrProvider is an autogenerated mock type for the PendingPrProvider type
+type PendingPrProvider struct {
+	mock.Mock
+}
+
+// AddEvent provides a mock function with given fields: ctx, event
+func (_m *PendingPrProvider) AddEvent(ctx context.Context, event *entity.PullRequestEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.PullRequestEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssignReviewer provides a mock function with given fields: ctx, prID, userID
+func (_m *PendingPrProvider) AssignReviewer(ctx context.Context, prID string, userID string) error {
+	ret := _m.Called(ctx, prID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignReviewer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, prID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetById provides a mock function with given fields: ctx, prID
+func (_m *PendingPrProvider) GetById(ctx context.Context, prID string) (*entity.PullRequest, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetById")
+	}
+
+	var r0 *entity.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.PullRequest, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.PullRequest); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPrReviewers provides a mock function with given fields: ctx, prID
+func (_m *PendingPrProvider) GetPrReviewers(ctx context.Context, prID string) ([]string, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrReviewers")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWithPendingReviewers provides a mock function with given fields: ctx
+func (_m *PendingPrProvider) GetWithPendingReviewers(ctx context.Context) ([]*entity.PullRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithPendingReviewers")
+	}
+
+	var r0 []*entity.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.PullRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.PullRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWithPendingReviewersInTeam provides a mock function with given fields: ctx, teamID
+func (_m *PendingPrProvider) GetWithPendingReviewersInTeam(ctx context.Context, teamID int) ([]*entity.PullRequest, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithPendingReviewersInTeam")
+	}
+
+	var r0 []*entity.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.PullRequest, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.PullRequest); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetPendingReviewers provides a mock function with given fields: ctx, prID, pending
+func (_m *PendingPrProvider) SetPendingReviewers(ctx context.Context, prID string, pending int) error {
+	ret := _m.Called(ctx, prID, pending)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPendingReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, prID, pending)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPendingPrProvider creates a new instance of PendingPrProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPendingPrProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PendingPrProvider {
+	mock := &PendingPrProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -14,17 +14,17 @@ type PrProvider struct {
 	mock.Mock
 }
 
-// DeleteReviewer provides a mock function with given fields: ctx, prID, userID
-func (_m *PrProvider) DeleteReviewer(ctx context.Context, prID string, userID string) error {
-	ret := _m.Called(ctx, prID, userID)
+// DeleteReviewer provides a mock function with given fields: ctx, prID, userID, reason
+func (_m *PrProvider) DeleteReviewer(ctx context.Context, prID string, userID string, reason string) error {
+	ret := _m.Called(ctx, prID, userID, reason)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteReviewer")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, prID, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, prID, userID, reason)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -32,6 +32,36 @@ func (_m *PrProvider) DeleteReviewer(ctx context.Context, prID string, userID st
 	return r0
 }
 
+// GetById provides a mock function with given fields: ctx, prID
+func (_m *PrProvider) GetById(ctx context.Context, prID string) (*entity.PullRequest, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetById")
+	}
+
+	var r0 *entity.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.PullRequest, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.PullRequest); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPrReviewers provides a mock function with given fields: ctx, prID
 func (_m *PrProvider) GetPrReviewers(ctx context.Context, prID string) ([]string, error) {
 	ret := _m.Called(ctx, prID)
@@ -92,17 +122,47 @@ func (_m *PrProvider) GetUserReviews(ctx context.Context, userID string) ([]*ent
 	return r0, r1
 }
 
-// ReassignReviewer provides a mock function with given fields: ctx, prID, oldUserID, newUserID
-func (_m *PrProvider) ReassignReviewer(ctx context.Context, prID string, oldUserID string, newUserID string) error {
-	ret := _m.Called(ctx, prID, oldUserID, newUserID)
+// ListUserReviews provides a mock function with given fields: ctx, filter
+func (_m *PrProvider) ListUserReviews(ctx context.Context, filter entity.ReviewFilter) ([]*entity.Review, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserReviews")
+	}
+
+	var r0 []*entity.Review
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ReviewFilter) ([]*entity.Review, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ReviewFilter) []*entity.Review); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Review)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.ReviewFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReassignReviewer provides a mock function with given fields: ctx, prID, oldUserID, newUserID, reason
+func (_m *PrProvider) ReassignReviewer(ctx context.Context, prID string, oldUserID string, newUserID string, reason string) error {
+	ret := _m.Called(ctx, prID, oldUserID, newUserID, reason)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReassignReviewer")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = rf(ctx, prID, oldUserID, newUserID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, prID, oldUserID, newUserID, reason)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -31,17 +31,17 @@ func (_m *ReviewerProvider) AssignReviewer(ctx context.Context, prID string, use
 	return r0
 }
 
-// DeleteReviewer provides a mock function with given fields: ctx, prID, userID
-func (_m *ReviewerProvider) DeleteReviewer(ctx context.Context, prID string, userID string) error {
-	ret := _m.Called(ctx, prID, userID)
+// DeleteReviewer provides a mock function with given fields: ctx, prID, userID, reason
+func (_m *ReviewerProvider) DeleteReviewer(ctx context.Context, prID string, userID string, reason string) error {
+	ret := _m.Called(ctx, prID, userID, reason)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteReviewer")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, prID, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, prID, userID, reason)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -79,17 +79,47 @@ func (_m *ReviewerProvider) GetPrReviewers(ctx context.Context, prID string) ([]
 	return r0, r1
 }
 
-// ReassignReviewer provides a mock function with given fields: ctx, prID, oldUserID, newUserID
-func (_m *ReviewerProvider) ReassignReviewer(ctx context.Context, prID string, oldUserID string, newUserID string) error {
-	ret := _m.Called(ctx, prID, oldUserID, newUserID)
+// GetReviewersByPrIDs provides a mock function with given fields: ctx, prIDs
+func (_m *ReviewerProvider) GetReviewersByPrIDs(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	ret := _m.Called(ctx, prIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewersByPrIDs")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]string, error)); ok {
+		return rf(ctx, prIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]string); ok {
+		r0 = rf(ctx, prIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, prIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReassignReviewer provides a mock function with given fields: ctx, prID, oldUserID, newUserID, reason
+func (_m *ReviewerProvider) ReassignReviewer(ctx context.Context, prID string, oldUserID string, newUserID string, reason string) error {
+	ret := _m.Called(ctx, prID, oldUserID, newUserID, reason)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReassignReviewer")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = rf(ctx, prID, oldUserID, newUserID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, prID, oldUserID, newUserID, reason)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -14,9 +14,9 @@ type StatsProvider struct {
 	mock.Mock
 }
 
-// GetAssignmentsCountStats provides a mock function with given fields: ctx, sort
-func (_m *StatsProvider) GetAssignmentsCountStats(ctx context.Context, sort string) ([]*entity.UserStatistics, error) {
-	ret := _m.Called(ctx, sort)
+// GetAssignmentsCountStats provides a mock function with given fields: ctx, filter
+func (_m *StatsProvider) GetAssignmentsCountStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.UserStatistics, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAssignmentsCountStats")
@@ -24,19 +24,19 @@ func (_m *StatsProvider) GetAssignmentsCountStats(ctx context.Context, sort stri
 
 	var r0 []*entity.UserStatistics
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.UserStatistics, error)); ok {
-		return rf(ctx, sort)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) ([]*entity.UserStatistics, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.UserStatistics); ok {
-		r0 = rf(ctx, sort)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) []*entity.UserStatistics); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entity.UserStatistics)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, sort)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,9 +44,99 @@ func (_m *StatsProvider) GetAssignmentsCountStats(ctx context.Context, sort stri
 	return r0, r1
 }
 
-// GetPrStats provides a mock function with given fields: ctx
-func (_m *StatsProvider) GetPrStats(ctx context.Context) (*entity.PrStatistics, error) {
-	ret := _m.Called(ctx)
+// GetGroupedAssignmentStats provides a mock function with given fields: ctx, filter
+func (_m *StatsProvider) GetGroupedAssignmentStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.GroupStatistics, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupedAssignmentStats")
+	}
+
+	var r0 []*entity.GroupStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) ([]*entity.GroupStatistics, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) []*entity.GroupStatistics); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.GroupStatistics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMemberLoad provides a mock function with given fields: ctx, filter
+func (_m *StatsProvider) GetMemberLoad(ctx context.Context, filter entity.StatsFilter) ([]*entity.MemberLoad, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberLoad")
+	}
+
+	var r0 []*entity.MemberLoad
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) ([]*entity.MemberLoad, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) []*entity.MemberLoad); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.MemberLoad)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMergeLatency provides a mock function with given fields: ctx, filter
+func (_m *StatsProvider) GetMergeLatency(ctx context.Context, filter entity.StatsFilter) ([]*entity.TeamLatency, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMergeLatency")
+	}
+
+	var r0 []*entity.TeamLatency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) ([]*entity.TeamLatency, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) []*entity.TeamLatency); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TeamLatency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPrStats provides a mock function with given fields: ctx, filter
+func (_m *StatsProvider) GetPrStats(ctx context.Context, filter entity.StatsFilter) (*entity.PrStatistics, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPrStats")
@@ -54,19 +144,19 @@ func (_m *StatsProvider) GetPrStats(ctx context.Context) (*entity.PrStatistics,
 
 	var r0 *entity.PrStatistics
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*entity.PrStatistics, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) (*entity.PrStatistics, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *entity.PrStatistics); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) *entity.PrStatistics); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.PrStatistics)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,6 +164,114 @@ func (_m *StatsProvider) GetPrStats(ctx context.Context) (*entity.PrStatistics,
 	return r0, r1
 }
 
+// GetReviewLatency provides a mock function with given fields: ctx, filter
+func (_m *StatsProvider) GetReviewLatency(ctx context.Context, filter entity.StatsFilter) ([]*entity.ReviewerLatency, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewLatency")
+	}
+
+	var r0 []*entity.ReviewerLatency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) ([]*entity.ReviewerLatency, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) []*entity.ReviewerLatency); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ReviewerLatency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamPrStats provides a mock function with given fields: ctx, filter
+func (_m *StatsProvider) GetTeamPrStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.TeamPrStatistics, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamPrStats")
+	}
+
+	var r0 []*entity.TeamPrStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) ([]*entity.TeamPrStatistics, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) []*entity.TeamPrStatistics); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TeamPrStatistics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetThroughput provides a mock function with given fields: ctx, filter
+func (_m *StatsProvider) GetThroughput(ctx context.Context, filter entity.StatsFilter) ([]*entity.WeeklyThroughput, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetThroughput")
+	}
+
+	var r0 []*entity.WeeklyThroughput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) ([]*entity.WeeklyThroughput, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.StatsFilter) []*entity.WeeklyThroughput); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.WeeklyThroughput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebuildRollups provides a mock function with given fields: ctx
+func (_m *StatsProvider) RebuildRollups(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebuildRollups")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewStatsProvider creates a new instance of StatsProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStatsProvider(t interface {
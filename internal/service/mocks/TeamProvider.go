@@ -42,6 +42,52 @@ func (_m *TeamProvider) Create(ctx context.Context, teamName string) (int, error
 	return r0, r1
 }
 
+// CreateRoutingRule provides a mock function with given fields: ctx, rule
+func (_m *TeamProvider) CreateRoutingRule(ctx context.Context, rule *entity.RoutingRule) (int, error) {
+	ret := _m.Called(ctx, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRoutingRule")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.RoutingRule) (int, error)); ok {
+		return rf(ctx, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.RoutingRule) int); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.RoutingRule) error); ok {
+		r1 = rf(ctx, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRoutingRule provides a mock function with given fields: ctx, ruleID
+func (_m *TeamProvider) DeleteRoutingRule(ctx context.Context, ruleID int) error {
+	ret := _m.Called(ctx, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoutingRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, ruleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetByTeamName provides a mock function with given fields: ctx, teamName
 func (_m *TeamProvider) GetByTeamName(ctx context.Context, teamName string) (*entity.Team, error) {
 	ret := _m.Called(ctx, teamName)
@@ -72,6 +118,192 @@ func (_m *TeamProvider) GetByTeamName(ctx context.Context, teamName string) (*en
 	return r0, r1
 }
 
+// GetMemberships provides a mock function with given fields: ctx
+func (_m *TeamProvider) GetMemberships(ctx context.Context) ([]*entity.TeamMembership, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberships")
+	}
+
+	var r0 []*entity.TeamMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.TeamMembership, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.TeamMembership); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TeamMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRoutingRule provides a mock function with given fields: ctx, ruleID
+func (_m *TeamProvider) GetRoutingRule(ctx context.Context, ruleID int) (*entity.RoutingRule, error) {
+	ret := _m.Called(ctx, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoutingRule")
+	}
+
+	var r0 *entity.RoutingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*entity.RoutingRule, error)); ok {
+		return rf(ctx, ruleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *entity.RoutingRule); ok {
+		r0 = rf(ctx, ruleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.RoutingRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, ruleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRoutingRules provides a mock function with given fields: ctx, teamID
+func (_m *TeamProvider) GetRoutingRules(ctx context.Context, teamID int) ([]*entity.RoutingRule, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoutingRules")
+	}
+
+	var r0 []*entity.RoutingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.RoutingRule, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.RoutingRule); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.RoutingRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSizeTiers provides a mock function with given fields: ctx, teamID
+func (_m *TeamProvider) GetSizeTiers(ctx context.Context, teamID int) ([]*entity.SizeTier, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSizeTiers")
+	}
+
+	var r0 []*entity.SizeTier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.SizeTier, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.SizeTier); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SizeTier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *TeamProvider) List(ctx context.Context) ([]*entity.Team, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*entity.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.Team, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.Team); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceSizeTiers provides a mock function with given fields: ctx, teamID, tiers
+func (_m *TeamProvider) ReplaceSizeTiers(ctx context.Context, teamID int, tiers []*entity.SizeTier) error {
+	ret := _m.Called(ctx, teamID, tiers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceSizeTiers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []*entity.SizeTier) error); ok {
+		r0 = rf(ctx, teamID, tiers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRoutingRule provides a mock function with given fields: ctx, rule
+func (_m *TeamProvider) UpdateRoutingRule(ctx context.Context, rule *entity.RoutingRule) error {
+	ret := _m.Called(ctx, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRoutingRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.RoutingRule) error); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTeamProvider creates a new instance of TeamProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTeamProvider(t interface {
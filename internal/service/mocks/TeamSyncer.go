@@ -0,0 +1,62 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "railgorail/avito/internal/transport/http/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	team "railgorail/avito/internal/service/team"
+)
+
+// TeamSyncer is an autogenerated mock type for the TeamSyncer type
+type TeamSyncer struct {
+	mock.Mock
+}
+
+// Sync provides a mock function with given fields: ctx, roster, opts
+func (_m *TeamSyncer) Sync(ctx context.Context, roster *dto.Roster, opts team.SyncOptions) (*dto.SyncResponse, error) {
+	ret := _m.Called(ctx, roster, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sync")
+	}
+
+	var r0 *dto.SyncResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Roster, team.SyncOptions) (*dto.SyncResponse, error)); ok {
+		return rf(ctx, roster, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.Roster, team.SyncOptions) *dto.SyncResponse); ok {
+		r0 = rf(ctx, roster, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SyncResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.Roster, team.SyncOptions) error); ok {
+		r1 = rf(ctx, roster, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTeamSyncer creates a new instance of TeamSyncer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTeamSyncer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TeamSyncer {
+	mock := &TeamSyncer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
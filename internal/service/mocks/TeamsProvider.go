@@ -0,0 +1,149 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	entity "railgorail/avito/internal/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TeamsProvider is an autogenerated mock type for the TeamsProvider type
+type TeamsProvider struct {
+	mock.Mock
+}
+
+// GetRoutingRules provides a mock function with given fields: ctx, teamID
+func (_m *TeamsProvider) GetRoutingRules(ctx context.Context, teamID int) ([]*entity.RoutingRule, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoutingRules")
+	}
+
+	var r0 []*entity.RoutingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.RoutingRule, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.RoutingRule); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.RoutingRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSizeTiers provides a mock function with given fields: ctx, teamID
+func (_m *TeamsProvider) GetSizeTiers(ctx context.Context, teamID int) ([]*entity.SizeTier, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSizeTiers")
+	}
+
+	var r0 []*entity.SizeTier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.SizeTier, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.SizeTier); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SizeTier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamsByUserID provides a mock function with given fields: ctx, userID
+func (_m *TeamsProvider) GetTeamsByUserID(ctx context.Context, userID string) ([]*entity.Team, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamsByUserID")
+	}
+
+	var r0 []*entity.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.Team, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.Team); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamsByUserIDs provides a mock function with given fields: ctx, userIDs
+func (_m *TeamsProvider) GetTeamsByUserIDs(ctx context.Context, userIDs []string) (map[string][]*entity.Team, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamsByUserIDs")
+	}
+
+	var r0 map[string][]*entity.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]*entity.Team, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]*entity.Team); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*entity.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTeamsProvider creates a new instance of TeamsProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTeamsProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TeamsProvider {
+	mock := &TeamsProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
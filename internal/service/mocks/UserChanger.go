@@ -14,6 +14,54 @@ type UserChanger struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: ctx, userID
+func (_m *UserChanger) Delete(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActiveUsersIDInTeam provides a mock function with given fields: ctx, teamID
+func (_m *UserChanger) GetActiveUsersIDInTeam(ctx context.Context, teamID int) ([]string, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveUsersIDInTeam")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]string, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []string); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetById provides a mock function with given fields: ctx, userID
 func (_m *UserChanger) GetById(ctx context.Context, userID string) (*entity.User, error) {
 	ret := _m.Called(ctx, userID)
@@ -44,6 +92,36 @@ func (_m *UserChanger) GetById(ctx context.Context, userID string) (*entity.User
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, filter
+func (_m *UserChanger) List(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UserFilter) ([]*entity.User, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UserFilter) []*entity.User); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.UserFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetIsActive provides a mock function with given fields: ctx, userID, isActive
 func (_m *UserChanger) SetIsActive(ctx context.Context, userID string, isActive bool) error {
 	ret := _m.Called(ctx, userID, isActive)
@@ -62,6 +140,42 @@ func (_m *UserChanger) SetIsActive(ctx context.Context, userID string, isActive
 	return r0
 }
 
+// SetIsSenior provides a mock function with given fields: ctx, userID, isSenior
+func (_m *UserChanger) SetIsSenior(ctx context.Context, userID string, isSenior bool) error {
+	ret := _m.Called(ctx, userID, isSenior)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetIsSenior")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, userID, isSenior)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateName provides a mock function with given fields: ctx, userID, name
+func (_m *UserChanger) UpdateName(ctx context.Context, userID string, name string) error {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateName")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewUserChanger creates a new instance of UserChanger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserChanger(t interface {
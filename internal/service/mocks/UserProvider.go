@@ -14,6 +14,24 @@ type UserProvider struct {
 	mock.Mock
 }
 
+// AddToTeam provides a mock function with given fields: ctx, userID, teamID
+func (_m *UserProvider) AddToTeam(ctx context.Context, userID string, teamID int) error {
+	ret := _m.Called(ctx, userID, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, userID, teamID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetActiveUsersIDInTeam provides a mock function with given fields: ctx, teamID
 func (_m *UserProvider) GetActiveUsersIDInTeam(ctx context.Context, teamID int) ([]string, error) {
 	ret := _m.Called(ctx, teamID)
@@ -44,6 +62,36 @@ func (_m *UserProvider) GetActiveUsersIDInTeam(ctx context.Context, teamID int)
 	return r0, r1
 }
 
+// GetAll provides a mock function with given fields: ctx
+func (_m *UserProvider) GetAll(ctx context.Context) ([]*entity.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetById provides a mock function with given fields: ctx, userID
 func (_m *UserProvider) GetById(ctx context.Context, userID string) (*entity.User, error) {
 	ret := _m.Called(ctx, userID)
@@ -104,6 +152,24 @@ func (_m *UserProvider) GetUsersInTeam(ctx context.Context, teamName string) ([]
 	return r0, r1
 }
 
+// RemoveFromTeam provides a mock function with given fields: ctx, userID, teamID
+func (_m *UserProvider) RemoveFromTeam(ctx context.Context, userID string, teamID int) error {
+	ret := _m.Called(ctx, userID, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, userID, teamID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Save provides a mock function with given fields: ctx, user
 func (_m *UserProvider) Save(ctx context.Context, user *entity.User) (string, error) {
 	ret := _m.Called(ctx, user)
@@ -72,6 +72,96 @@ func (_m *PrController) GetById(ctx context.Context, prID string) (*entity.PullR
 	return r0, r1
 }
 
+// GetEvents provides a mock function with given fields: ctx, prID
+func (_m *PrController) GetEvents(ctx context.Context, prID string) ([]*entity.PullRequestEvent, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []*entity.PullRequestEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.PullRequestEvent, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.PullRequestEvent); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PullRequestEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStack provides a mock function with given fields: ctx, prID
+func (_m *PrController) GetStack(ctx context.Context, prID string) ([]*entity.PullRequest, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStack")
+	}
+
+	var r0 []*entity.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.PullRequest, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.PullRequest); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter
+func (_m *PrController) List(ctx context.Context, filter entity.PullRequestFilter) ([]*entity.PullRequest, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*entity.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.PullRequestFilter) ([]*entity.PullRequest, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.PullRequestFilter) []*entity.PullRequest); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.PullRequestFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarkAsMerged provides a mock function with given fields: ctx, prID
 func (_m *PrController) MarkAsMerged(ctx context.Context, prID string) error {
 	ret := _m.Called(ctx, prID)
@@ -90,6 +180,42 @@ func (_m *PrController) MarkAsMerged(ctx context.Context, prID string) error {
 	return r0
 }
 
+// SetLabels provides a mock function with given fields: ctx, prID, labels
+func (_m *PrController) SetLabels(ctx context.Context, prID string, labels []string) error {
+	ret := _m.Called(ctx, prID, labels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLabels")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, prID, labels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, prID, upd
+func (_m *PrController) Update(ctx context.Context, prID string, upd entity.PullRequestUpdate) error {
+	ret := _m.Called(ctx, prID, upd)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.PullRequestUpdate) error); ok {
+		r0 = rf(ctx, prID, upd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewPrController creates a new instance of PrController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPrController(t interface {
@@ -14,6 +14,36 @@ type UserGetter struct {
 	mock.Mock
 }
 
+// GetActiveSeniorsIDInTeam provides a mock function with given fields: ctx, teamID
+func (_m *UserGetter) GetActiveSeniorsIDInTeam(ctx context.Context, teamID int) ([]string, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSeniorsIDInTeam")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]string, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []string); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetActiveUsersIDInTeam provides a mock function with given fields: ctx, teamID
 func (_m *UserGetter) GetActiveUsersIDInTeam(ctx context.Context, teamID int) ([]string, error) {
 	ret := _m.Called(ctx, teamID)
@@ -2,45 +2,75 @@ package pr
 
 import (
 	"context"
-	"math/rand/v2"
+	"errors"
+	"fmt"
 	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib"
+	"railgorail/avito/internal/metrics"
 	"railgorail/avito/internal/repo"
 	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/tracing"
 	"railgorail/avito/internal/transport/http/dto"
 	"slices"
+	"strings"
+	"time"
 )
 
 const (
 	StatusOpen   = "OPEN"
 	StatusMerged = "MERGED"
+
+	// defaultReviewers is used when the PR size is unknown or no tier matches.
+	defaultReviewers = 2
+
+	// MergePolicyBlock refuses to merge a stacked PR before its parent,
+	// MergePolicyAllow merges it anyway.
+	MergePolicyBlock = "block"
+	MergePolicyAllow = "allow"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=PrController
 type PrController interface {
 	Create(ctx context.Context, pr *entity.PullRequest) (string, error)
 	GetById(ctx context.Context, prID string) (*entity.PullRequest, error)
+	List(ctx context.Context, filter entity.PullRequestFilter) ([]*entity.PullRequest, error)
 	MarkAsMerged(ctx context.Context, prID string) error
+	Update(ctx context.Context, prID string, upd entity.PullRequestUpdate) error
+	SetLabels(ctx context.Context, prID string, labels []string) error
+	GetStack(ctx context.Context, prID string) ([]*entity.PullRequest, error)
+	GetEvents(ctx context.Context, prID string) ([]*entity.PullRequestEvent, error)
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=ReviewerProvider
 type ReviewerProvider interface {
 	GetPrReviewers(ctx context.Context, prID string) ([]string, error)
+	GetReviewersByPrIDs(ctx context.Context, prIDs []string) (map[string][]string, error)
 	AssignReviewer(ctx context.Context, prID, userID string) error
-	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) error
-	DeleteReviewer(ctx context.Context, prID, userID string) error
+	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID, reason string) error
+	DeleteReviewer(ctx context.Context, prID, userID, reason string) error
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=UserGetter
 type UserGetter interface {
 	GetActiveUsersIDInTeam(ctx context.Context, teamID int) ([]string, error)
+	GetActiveSeniorsIDInTeam(ctx context.Context, teamID int) ([]string, error)
 	GetById(ctx context.Context, userID string) (*entity.User, error)
 }
 
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=TeamsProvider
+type TeamsProvider interface {
+	GetTeamsByUserID(ctx context.Context, userID string) ([]*entity.Team, error)
+	GetSizeTiers(ctx context.Context, teamID int) ([]*entity.SizeTier, error)
+	GetRoutingRules(ctx context.Context, teamID int) ([]*entity.RoutingRule, error)
+}
+
 type PullRequestService struct {
 	prController     PrController
 	userGetter       UserGetter
 	reviewerProvider ReviewerProvider
+	teamsProvider    TeamsProvider
 	trm              service.TransactionManager
+	mergePolicy      string
 }
 
 func NewPullRequestService(
@@ -48,46 +78,135 @@ func NewPullRequestService(
 	prController PrController,
 	reviewerProvider ReviewerProvider,
 	userGetter UserGetter,
+	teamsProvider TeamsProvider,
+	mergePolicy string,
 ) *PullRequestService {
 	return &PullRequestService{
 		trm:              trm,
 		prController:     prController,
 		userGetter:       userGetter,
 		reviewerProvider: reviewerProvider,
+		teamsProvider:    teamsProvider,
+		mergePolicy:      mergePolicy,
 	}
 }
 
-func (s *PullRequestService) Create(ctx context.Context, prID, prName, authorId string) (*dto.PullRequestSchema, error) {
+// Create opens a PR in the author's home team. teamName may be omitted when
+// the author belongs to exactly one team. When the size is reported the
+// team's size tiers decide how many reviewers, and how many seniors, it gets.
+// The team's routing rules for the PR's labels are applied on top. A PR
+// stacked on parentID starts with the parent's reviewers that are active in
+// its team when inheritReviewers is set, the tier only tops them up.
+func (s *PullRequestService) Create(ctx context.Context, prID, prName, authorId, teamName string, size entity.PullRequestSize, labels []string, parentID string, inheritReviewers bool) (*dto.PullRequestSchema, error) {
+	ctx, span := tracing.Start(ctx, "PullRequestService.Create")
+	defer span.End()
+
+	if parentID == prID {
+		return nil, service.Fail(repo.ErrInvalidParent, "PR cannot be its own parent", "parent_id", parentID)
+	}
 
 	pr := &entity.PullRequest{
-		ID:       prID,
-		Title:    prName,
-		AuthorId: authorId,
-		Status:   StatusOpen,
+		ID:              prID,
+		Title:           prName,
+		AuthorId:        authorId,
+		Status:          StatusOpen,
+		Labels:          normalizeLabels(labels),
+		PullRequestSize: size,
 	}
+	if parentID != "" {
+		pr.ParentID = &parentID
+	}
+	lines, sizeKnown := size.Lines()
+	pr.Size = lines
 
 	resp := &dto.PullRequestSchema{
 		AssignedReviewers: make([]string, 0, 2),
 	}
 
 	err := s.trm.Do(ctx, func(ctx context.Context) error {
-		author, err := s.userGetter.GetById(ctx, authorId)
+		_, err := s.userGetter.GetById(ctx, authorId)
+		if err != nil {
+			return err
+		}
+
+		teams, err := s.teamsProvider.GetTeamsByUserID(ctx, authorId)
+		if err != nil {
+			return err
+		}
+
+		team, err := homeTeam(teams, teamName)
+		if err != nil {
+			return err
+		}
+		pr.TeamID = team.ID
+
+		activeUsers, err := s.userGetter.GetActiveUsersIDInTeam(ctx, team.ID)
 		if err != nil {
 			return err
 		}
 
-		teamID := author.TeamID
-		activeUsers, err := s.userGetter.GetActiveUsersIDInTeam(ctx, teamID)
+		count, seniors := defaultReviewers, 0
+		if sizeKnown {
+			tiers, err := s.teamsProvider.GetSizeTiers(ctx, team.ID)
+			if err != nil {
+				return err
+			}
+			if tier := matchTier(tiers, lines); tier != nil {
+				count, seniors = tier.Reviewers, tier.SeniorReviewers
+			}
+		}
+
+		rules, err := s.matchingRules(ctx, team.ID, pr.Labels)
+		if err != nil {
+			return err
+		}
+		if n, ok := reviewersOverride(rules); ok {
+			count, seniors = n, min(seniors, n)
+		}
+
+		inherited, err := s.inheritedReviewers(ctx, parentID, inheritReviewers, authorId, activeUsers)
+		if err != nil {
+			return err
+		}
+
+		var seniorIDs []string
+		if seniors > 0 {
+			seniorIDs, err = s.userGetter.GetActiveSeniorsIDInTeam(ctx, team.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		// inherited reviewers keep their seats within the child's tier,
+		// then seniors, the rest of the seats go to anyone active; a team
+		// short of seniors still gets a full set of reviewers
+		reviewers, haveSeniors := keepInherited(inherited, seniorIDs, count, seniors)
+		if missing := seniors - haveSeniors; missing > 0 {
+			excluded := append([]string{authorId}, reviewers...)
+			reviewers = append(reviewers, lib.RandomUsers(seniorIDs, missing, excluded...)...)
+		}
+		excluded := append([]string{authorId}, reviewers...)
+		reviewers = append(reviewers, lib.RandomUsers(activeUsers, count-len(reviewers), excluded...)...)
+		// seats nobody could take are left for the reconciler
+		pr.PendingReviewers = max(0, count-len(reviewers))
+
+		extra, err := s.targetTeamReviewers(ctx, rules, authorId, reviewers)
 		if err != nil {
 			return err
 		}
-		reviewers := getRandomUsers(activeUsers, 2, authorId)
+		reviewers = append(reviewers, extra...)
 
 		createdPrID, err := s.prController.Create(ctx, pr)
 		if err != nil {
 			return err
 		}
 
+		if len(pr.Labels) > 0 {
+			if err := s.prController.SetLabels(ctx, createdPrID, pr.Labels); err != nil {
+				return err
+			}
+		}
+
 		for _, r := range reviewers {
 			err = s.reviewerProvider.AssignReviewer(ctx, createdPrID, r)
 			if err != nil {
@@ -99,17 +218,170 @@ func (s *PullRequestService) Create(ctx context.Context, prID, prName, authorId
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, service.With(err, "pull_request_id", prID, "author_id", authorId)
+	}
+
+	metrics.PullRequestsCreated.Inc()
+	if resp.PendingReviewers > 0 {
+		metrics.NoCandidate.WithLabelValues(metrics.OperationCreate).Inc()
 	}
 	return resp, nil
 }
 
+// inheritedReviewers checks that the parent PR exists and returns its
+// reviewers that may review the child too.
+func (s *PullRequestService) inheritedReviewers(ctx context.Context, parentID string, inherit bool, authorID string, activeUsers []string) ([]string, error) {
+	if parentID == "" {
+		return []string{}, nil
+	}
+
+	if _, err := s.prController.GetById(ctx, parentID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, service.Fail(repo.ErrInvalidParent, fmt.Sprintf("parent %s not found", parentID), "parent_id", parentID)
+		}
+		return nil, err
+	}
+	if !inherit {
+		return []string{}, nil
+	}
+
+	parentReviewers, err := s.reviewerProvider.GetPrReviewers(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	inherited := make([]string, 0, len(parentReviewers))
+	for _, r := range parentReviewers {
+		if r != authorID && slices.Contains(activeUsers, r) {
+			inherited = append(inherited, r)
+		}
+	}
+	return inherited, nil
+}
+
+// keepInherited picks at most count of the inherited reviewers. Seniors go
+// first, and non-seniors leave room for the seniors the tier still misses.
+// It returns the picked reviewers and how many of them are seniors.
+func keepInherited(inherited, seniorIDs []string, count, seniors int) ([]string, int) {
+	kept := make([]string, 0, min(len(inherited), count))
+	haveSeniors := 0
+	for _, id := range inherited {
+		if len(kept) < count && slices.Contains(seniorIDs, id) {
+			kept = append(kept, id)
+			haveSeniors++
+		}
+	}
+	for _, id := range inherited {
+		if len(kept)+max(0, seniors-haveSeniors) >= count {
+			break
+		}
+		if !slices.Contains(seniorIDs, id) {
+			kept = append(kept, id)
+		}
+	}
+	return kept, haveSeniors
+}
+
+// matchingRules returns the team's routing rules for any of labels.
+func (s *PullRequestService) matchingRules(ctx context.Context, teamID int, labels []string) ([]*entity.RoutingRule, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	rules, err := s.teamsProvider.GetRoutingRules(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*entity.RoutingRule, 0, len(rules))
+	for _, r := range rules {
+		if slices.Contains(labels, r.Label) {
+			matched = append(matched, r)
+		}
+	}
+	return matched, nil
+}
+
+// reviewersOverride is the largest count among set_reviewers rules. It
+// replaces the tier's count, so such a PR may get fewer reviewers than its
+// size would ask for.
+func reviewersOverride(rules []*entity.RoutingRule) (int, bool) {
+	count, ok := 0, false
+	for _, r := range rules {
+		if r.Action == entity.RuleActionSetReviewers && r.Reviewers != nil {
+			count, ok = max(count, *r.Reviewers), true
+		}
+	}
+	return count, ok
+}
+
+// targetTeamReviewers picks one active member of every add_team_member
+// target team that none of the current reviewers belongs to. A target team
+// without free members is skipped, the rule must not block the PR.
+func (s *PullRequestService) targetTeamReviewers(ctx context.Context, rules []*entity.RoutingRule, authorID string, current []string) ([]string, error) {
+	var added []string
+	for _, r := range rules {
+		if r.Action != entity.RuleActionAddTeamMember || r.TargetTeamID == nil {
+			continue
+		}
+
+		members, err := s.userGetter.GetActiveUsersIDInTeam(ctx, *r.TargetTeamID)
+		if err != nil {
+			return nil, err
+		}
+
+		excluded := append([]string{authorID}, current...)
+		excluded = append(excluded, added...)
+		if slices.ContainsFunc(members, func(id string) bool {
+			return id != authorID && slices.Contains(excluded, id)
+		}) {
+			continue
+		}
+
+		added = append(added, lib.RandomUsers(members, 1, excluded...)...)
+	}
+	return added, nil
+}
+
+// matchTier expects tiers ordered by MaxLines with the unbounded one last.
+func matchTier(tiers []*entity.SizeTier, lines int) *entity.SizeTier {
+	for _, t := range tiers {
+		if t.MaxLines == nil || lines <= *t.MaxLines {
+			return t
+		}
+	}
+	return nil
+}
+
+func homeTeam(teams []*entity.Team, teamName string) (*entity.Team, error) {
+	if teamName == "" {
+		switch len(teams) {
+		case 0:
+			return nil, repo.ErrNotFound
+		case 1:
+			return teams[0], nil
+		default:
+			return nil, repo.ErrTeamRequired
+		}
+	}
+
+	for _, t := range teams {
+		if t.Name == teamName {
+			return t, nil
+		}
+	}
+	return nil, repo.ErrNotTeamMember
+}
+
 func (s *PullRequestService) Merge(ctx context.Context, prID string) (*dto.PullRequestSchema, error) {
+	ctx, span := tracing.Start(ctx, "PullRequestService.Merge")
+	defer span.End()
 
 	resp := &dto.PullRequestSchema{
 		AssignedReviewers: make([]string, 0, 2),
 	}
 
+	merged := false
 	err := s.trm.Do(ctx, func(ctx context.Context) error {
 		pr, err := s.prController.GetById(ctx, prID)
 		if err != nil {
@@ -117,7 +389,14 @@ func (s *PullRequestService) Merge(ctx context.Context, prID string) (*dto.PullR
 		}
 
 		if pr.Status == StatusOpen {
-			_ = s.prController.MarkAsMerged(ctx, pr.ID)
+			if err := s.checkParentMerged(ctx, pr); err != nil {
+				return err
+			}
+			// the rollups and assignment outcomes are written here too
+			if err := s.prController.MarkAsMerged(ctx, pr.ID); err != nil {
+				return err
+			}
+			merged = true
 		}
 
 		pr, err = s.prController.GetById(ctx, prID)
@@ -134,12 +413,109 @@ func (s *PullRequestService) Merge(ctx context.Context, prID string) (*dto.PullR
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, service.With(err, "pull_request_id", prID)
+	}
+
+	if merged {
+		metrics.PullRequestsMerged.Inc()
+	}
+	return resp, nil
+}
+
+// checkParentMerged applies the merge policy to a stacked PR.
+func (s *PullRequestService) checkParentMerged(ctx context.Context, pr *entity.PullRequest) error {
+	if pr.ParentID == nil || s.mergePolicy == MergePolicyAllow {
+		return nil
+	}
+
+	parent, err := s.prController.GetById(ctx, *pr.ParentID)
+	if err != nil {
+		return err
+	}
+	if parent.Status != StatusMerged {
+		return service.Fail(repo.ErrParentNotMerged, "", "parent_id", parent.ID)
+	}
+	return nil
+}
+
+// Stack returns the stack prID belongs to, from the root PR down.
+func (s *PullRequestService) Stack(ctx context.Context, prID string) (*dto.StackResponse, error) {
+	ctx, span := tracing.Start(ctx, "PullRequestService.Stack")
+	defer span.End()
+
+	resp := &dto.StackResponse{
+		PullRequestID: prID,
+		PullRequests:  []dto.PullRequestSchema{},
+	}
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		prs, err := s.prController.GetStack(ctx, prID)
+		if err != nil {
+			return err
+		}
+
+		prIDs := make([]string, 0, len(prs))
+		for _, pr := range prs {
+			prIDs = append(prIDs, pr.ID)
+		}
+		reviewers, err := s.reviewerProvider.GetReviewersByPrIDs(ctx, prIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, pr := range prs {
+			item := dto.PullRequestSchema{AssignedReviewers: make([]string, 0, 2)}
+			toPullRequestSchema(&item, pr, reviewers[pr.ID])
+			resp.PullRequests = append(resp.PullRequests, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, service.With(err, "pull_request_id", prID)
+	}
+	return resp, nil
+}
+
+// History returns the PR's events, oldest first.
+func (s *PullRequestService) History(ctx context.Context, prID string) (*dto.HistoryResponse, error) {
+	ctx, span := tracing.Start(ctx, "PullRequestService.History")
+	defer span.End()
+
+	resp := &dto.HistoryResponse{
+		PullRequestID: prID,
+		Events:        []dto.PullRequestEvent{},
+	}
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		if _, err := s.prController.GetById(ctx, prID); err != nil {
+			return err
+		}
+
+		events, err := s.prController.GetEvents(ctx, prID)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			resp.Events = append(resp.Events, dto.PullRequestEvent{
+				Type:      e.Type,
+				UserID:    e.UserID,
+				Details:   e.Details,
+				CreatedAt: e.CreatedAt,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, service.With(err, "pull_request_id", prID)
 	}
 	return resp, nil
 }
 
 func (s *PullRequestService) Reassign(ctx context.Context, prID, oldRev string) (*dto.ReassignResponse, error) {
+	ctx, span := tracing.Start(ctx, "PullRequestService.Reassign")
+	defer span.End()
+
 	resp := &dto.ReassignResponse{
 		PullRequest: dto.PullRequestSchema{
 			AssignedReviewers: make([]string, 0, 2),
@@ -156,12 +532,7 @@ func (s *PullRequestService) Reassign(ctx context.Context, prID, oldRev string)
 			return repo.ErrPRMerged
 		}
 
-		author, err := s.userGetter.GetById(ctx, pr.AuthorId)
-		if err != nil {
-			return err
-		}
-		teamID := author.TeamID
-		activeUsers, err := s.userGetter.GetActiveUsersIDInTeam(ctx, teamID)
+		activeUsers, err := s.userGetter.GetActiveUsersIDInTeam(ctx, pr.TeamID)
 		if err != nil {
 			return err
 		}
@@ -175,16 +546,16 @@ func (s *PullRequestService) Reassign(ctx context.Context, prID, oldRev string)
 			return repo.ErrNotAssigned
 		}
 
-		exludedReviewers := []string{author.ID}
+		exludedReviewers := []string{pr.AuthorId}
 		exludedReviewers = append(exludedReviewers, assignedReviewers...)
 
 		var newRev string
 		if len(exludedReviewers) >= len(activeUsers) {
 			return repo.ErrNoCandidate
 		} else {
-			newRev = getRandomUsers(activeUsers, 1, exludedReviewers...)[0]
+			newRev = lib.RandomUsers(activeUsers, 1, exludedReviewers...)[0]
 
-			err = s.reviewerProvider.ReassignReviewer(ctx, prID, oldRev, newRev)
+			err = s.reviewerProvider.ReassignReviewer(ctx, prID, oldRev, newRev, entity.UnassignReassigned)
 		}
 		if err != nil {
 			return err
@@ -204,48 +575,228 @@ func (s *PullRequestService) Reassign(ctx context.Context, prID, oldRev string)
 		resp.ReplacedBy = newRev
 		return nil
 	})
+	if errors.Is(err, repo.ErrNoCandidate) {
+		metrics.NoCandidate.WithLabelValues(metrics.OperationReassign).Inc()
+	}
 	if err != nil {
-		return nil, err
+		return nil, service.With(err, "pull_request_id", prID, "old_reviewer_id", oldRev)
 	}
+
+	metrics.Reassignments.WithLabelValues(entity.UnassignReassigned).Inc()
 	return resp, nil
 }
 
-func toPullRequestSchema(resp *dto.PullRequestSchema, pr *entity.PullRequest, reviewers []string) {
-	resp.ID = pr.ID
-	resp.Name = pr.Title
-	resp.AuthorID = pr.AuthorId
-	resp.Status = pr.Status
-	resp.AssignedReviewers = append(resp.AssignedReviewers, reviewers...)
-	resp.MergedAt = pr.MergedAt
+// Update edits PR metadata. Merged PRs may be edited too. New labels on an
+// OPEN PR run the routing rules again, which may add reviewers but never
+// removes any; reviewers of a merged PR are never touched.
+func (s *PullRequestService) Update(ctx context.Context, prID string, upd entity.PullRequestUpdate) (*dto.PullRequestSchema, error) {
+	ctx, span := tracing.Start(ctx, "PullRequestService.Update")
+	defer span.End()
+
+	resp := &dto.PullRequestSchema{
+		AssignedReviewers: make([]string, 0, 2),
+	}
+
+	if upd.Labels != nil {
+		upd.Labels = normalizeLabels(upd.Labels)
+	}
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		if err := s.prController.Update(ctx, prID, upd); err != nil {
+			return err
+		}
+
+		if upd.Labels != nil {
+			if err := s.prController.SetLabels(ctx, prID, upd.Labels); err != nil {
+				return err
+			}
+		}
+
+		pr, err := s.prController.GetById(ctx, prID)
+		if err != nil {
+			return err
+		}
+
+		reviewers, err := s.reviewerProvider.GetPrReviewers(ctx, prID)
+		if err != nil {
+			return err
+		}
+
+		if upd.Labels != nil && pr.Status == StatusOpen {
+			added, err := s.routeLabelled(ctx, pr, reviewers)
+			if err != nil {
+				return err
+			}
+			reviewers = append(reviewers, added...)
+		}
+
+		toPullRequestSchema(resp, pr, reviewers)
+		return nil
+	})
+	if err != nil {
+		return nil, service.With(err, "pull_request_id", prID)
+	}
+	return resp, nil
 }
 
-func getRandomUsers(candidates []string, maxCount int, excludedIDs ...string) []string {
-	if len(candidates) == 0 || maxCount <= 0 {
-		return []string{}
+// routeLabelled applies the routing rules for the labels of an open PR that
+// already has reviewers and assigns whoever is missing: set_reviewers tops
+// the PR up from its own team, add_team_member adds a target team member.
+func (s *PullRequestService) routeLabelled(ctx context.Context, pr *entity.PullRequest, current []string) ([]string, error) {
+	rules, err := s.matchingRules(ctx, pr.TeamID, pr.Labels)
+	if err != nil || len(rules) == 0 {
+		return nil, err
 	}
 
-	excluded := make(map[string]struct{}, len(excludedIDs))
-	for _, id := range excludedIDs {
-		excluded[id] = struct{}{}
+	var added []string
+	if n, ok := reviewersOverride(rules); ok && n > len(current) {
+		activeUsers, err := s.userGetter.GetActiveUsersIDInTeam(ctx, pr.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		excluded := append([]string{pr.AuthorId}, current...)
+		added = lib.RandomUsers(activeUsers, n-len(current), excluded...)
+	}
+
+	extra, err := s.targetTeamReviewers(ctx, rules, pr.AuthorId, append(slices.Clone(current), added...))
+	if err != nil {
+		return nil, err
+	}
+	added = append(added, extra...)
+
+	for _, r := range added {
+		if err := s.reviewerProvider.AssignReviewer(ctx, pr.ID, r); err != nil {
+			return nil, err
+		}
 	}
+	return added, nil
+}
 
-	var available []string
-	for _, user := range candidates {
-		if _, skip := excluded[user]; !skip {
-			available = append(available, user)
+// normalizeLabels trims labels and drops duplicates, keeping the first order.
+func normalizeLabels(labels []string) []string {
+	normalized := make([]string, 0, len(labels))
+	for _, l := range labels {
+		l = strings.TrimSpace(l)
+		if l != "" && !slices.Contains(normalized, l) {
+			normalized = append(normalized, l)
 		}
 	}
+	return normalized
+}
+
+func (s *PullRequestService) Get(ctx context.Context, prID string) (*dto.PullRequestSchema, error) {
+	ctx, span := tracing.Start(ctx, "PullRequestService.Get")
+	defer span.End()
 
-	if len(available) == 0 {
-		return []string{}
+	resp := &dto.PullRequestSchema{
+		AssignedReviewers: make([]string, 0, 2),
 	}
 
-	rand.Shuffle(len(available), func(i, j int) {
-		available[i], available[j] = available[j], available[i]
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		pr, err := s.prController.GetById(ctx, prID)
+		if err != nil {
+			return err
+		}
+
+		reviewers, err := s.reviewerProvider.GetPrReviewers(ctx, prID)
+		if err != nil {
+			return err
+		}
+
+		toPullRequestSchema(resp, pr, reviewers)
+		return nil
 	})
+	if err != nil {
+		return nil, service.With(err, "pull_request_id", prID)
+	}
+	return resp, nil
+}
 
-	if len(available) > maxCount {
-		return available[:maxCount]
+// List returns one page of PRs. The page is read one item longer to know
+// whether next_cursor is needed.
+func (s *PullRequestService) List(ctx context.Context, filter entity.PullRequestFilter) (*dto.PrListResponse, error) {
+	ctx, span := tracing.Start(ctx, "PullRequestService.List")
+	defer span.End()
+
+	resp := &dto.PrListResponse{
+		PullRequests: []dto.PullRequestSchema{},
 	}
-	return available
+
+	limit := filter.Limit
+	filter.Limit++
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		prs, err := s.prController.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		hasMore := len(prs) > limit
+		if hasMore {
+			prs = prs[:limit]
+		}
+		if len(prs) == 0 {
+			return nil
+		}
+
+		prIDs := make([]string, 0, len(prs))
+		for _, pr := range prs {
+			prIDs = append(prIDs, pr.ID)
+		}
+		reviewers, err := s.reviewerProvider.GetReviewersByPrIDs(ctx, prIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, pr := range prs {
+			item := dto.PullRequestSchema{AssignedReviewers: make([]string, 0, 2)}
+			toPullRequestSchema(&item, pr, reviewers[pr.ID])
+			resp.PullRequests = append(resp.PullRequests, item)
+		}
+
+		if hasMore {
+			last := prs[len(prs)-1]
+			resp.NextCursor = dto.Cursor{
+				Sort:  filter.SortBy,
+				Desc:  filter.Desc,
+				Value: sortValue(last, filter.SortBy),
+				ID:    last.ID,
+			}.Encode()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func sortValue(pr *entity.PullRequest, sortBy string) string {
+	t := pr.CreatedAt
+	if sortBy == entity.PullRequestSortMergedAt {
+		t = pr.MergedAt
+	}
+	if t == nil {
+		return "infinity"
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func toPullRequestSchema(resp *dto.PullRequestSchema, pr *entity.PullRequest, reviewers []string) {
+	resp.ID = pr.ID
+	resp.Name = pr.Title
+	resp.AuthorID = pr.AuthorId
+	resp.Status = pr.Status
+	resp.AssignedReviewers = append(resp.AssignedReviewers, reviewers...)
+	resp.Description = pr.Description
+	resp.Labels = append(make([]string, 0, len(pr.Labels)), pr.Labels...)
+	resp.URL = pr.URL
+	resp.Size = pr.Size
+	resp.Additions = pr.Additions
+	resp.Deletions = pr.Deletions
+	resp.FilesChanged = pr.FilesChanged
+	resp.ParentID = pr.ParentID
+	resp.PendingReviewers = pr.PendingReviewers
+	resp.CreatedAt = pr.CreatedAt
+	resp.MergedAt = pr.MergedAt
 }
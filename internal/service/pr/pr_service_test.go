@@ -3,14 +3,19 @@ package pr_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
 	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/metrics"
 	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service"
 	"railgorail/avito/internal/service/mocks"
 	"railgorail/avito/internal/service/pr"
+	"railgorail/avito/internal/transport/http/dto"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,15 +30,17 @@ func TestPullRequestService_Create_Success_NoAvailableCandidates(t *testing.T) {
 	mockPr := mocks.NewPrController(t)
 	mockUser := mocks.NewUserGetter(t)
 	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
 	mockTxManager := &mocks.MockManager{}
 	mockTxManager.Test(t)
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
-	authorUser := &entity.User{ID: authorID, TeamID: teamID}
+	authorUser := &entity.User{ID: authorID}
 	activeUserIDs := []string{authorID}
 
 	mockPr.On("Create", ctx, mock.AnythingOfType("*entity.PullRequest")).Return(prID, nil).Once()
 	mockUser.On("GetById", ctx, authorID).Return(authorUser, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
 	mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).Return(activeUserIDs, nil).Once()
 
 	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
@@ -42,8 +49,8 @@ func TestPullRequestService_Create_Success_NoAvailableCandidates(t *testing.T) {
 			assert.NoError(t, fn(ctx))
 		}).Return(nil).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
-	result, e := service.Create(ctx, prID, prName, authorID)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, prName, authorID, "", entity.PullRequestSize{}, nil, "", true)
 
 	assert.NoError(t, e)
 	assert.NotNil(t, result)
@@ -52,6 +59,7 @@ func TestPullRequestService_Create_Success_NoAvailableCandidates(t *testing.T) {
 	assert.Equal(t, authorID, result.AuthorID)
 	assert.Equal(t, pr.StatusOpen, result.Status)
 	assert.Empty(t, result.AssignedReviewers)
+	assert.Equal(t, 2, result.PendingReviewers)
 }
 
 func TestPullRequestService_Create_AssignReviewerFailsOnSecond(t *testing.T) {
@@ -64,15 +72,17 @@ func TestPullRequestService_Create_AssignReviewerFailsOnSecond(t *testing.T) {
 	mockPr := mocks.NewPrController(t)
 	mockUser := mocks.NewUserGetter(t)
 	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
 	mockTxManager := &mocks.MockManager{}
 	mockTxManager.Test(t)
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
-	authorUser := &entity.User{ID: authorID, TeamID: teamID}
+	authorUser := &entity.User{ID: authorID}
 	activeUserIDs := []string{"rev-20", "rev-30", "rev-40"}
 
 	mockPr.On("Create", ctx, mock.AnythingOfType("*entity.PullRequest")).Return(prID, nil).Once()
 	mockUser.On("GetById", ctx, authorID).Return(authorUser, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
 	mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).Return(activeUserIDs, nil).Once()
 
 	assignError := errors.New("failed to assign")
@@ -91,8 +101,8 @@ func TestPullRequestService_Create_AssignReviewerFailsOnSecond(t *testing.T) {
 			assert.Equal(t, assignError, e)
 		}).Return(assignError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
-	result, e := service.Create(ctx, prID, prName, authorID)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, prName, authorID, "", entity.PullRequestSize{}, nil, "", true)
 
 	assert.Nil(t, result)
 	assert.Error(t, e)
@@ -109,14 +119,16 @@ func TestPullRequestService_Create_GetActiveUsersError(t *testing.T) {
 	mockPr := mocks.NewPrController(t)
 	mockUser := mocks.NewUserGetter(t)
 	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
 	mockTxManager := &mocks.MockManager{}
 	mockTxManager.Test(t)
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
-	authorUser := &entity.User{ID: authorID, TeamID: teamID}
+	authorUser := &entity.User{ID: authorID}
 	activeError := errors.New("user service unavailable")
 
 	mockUser.On("GetById", ctx, authorID).Return(authorUser, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
 	mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).Return(([]string)(nil), activeError).Once()
 
 	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
@@ -127,8 +139,8 @@ func TestPullRequestService_Create_GetActiveUsersError(t *testing.T) {
 			assert.Equal(t, activeError, e)
 		}).Return(activeError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
-	result, e := service.Create(ctx, prID, prName, authorID)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, prName, authorID, "", entity.PullRequestSize{}, nil, "", true)
 
 	assert.Nil(t, result)
 	assert.Error(t, e)
@@ -137,6 +149,88 @@ func TestPullRequestService_Create_GetActiveUsersError(t *testing.T) {
 	mockReviewer.AssertNotCalled(t, "AssignReviewer", mock.Anything, mock.Anything, mock.Anything)
 }
 
+func TestPullRequestService_Create_UsesRequestedHomeTeam(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-delta"
+	authorID := "author-11"
+
+	mockPr := mocks.NewPrController(t)
+	mockUser := mocks.NewUserGetter(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	teams := []*entity.Team{{ID: 1, Name: "payments"}, {ID: 2, Name: "search"}}
+
+	mockUser.On("GetById", ctx, authorID).Return(&entity.User{ID: authorID}, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return(teams, nil).Once()
+	mockUser.On("GetActiveUsersIDInTeam", ctx, 2).Return([]string{authorID, "rev-s"}, nil).Once()
+	mockPr.On("Create", ctx, mock.MatchedBy(func(p *entity.PullRequest) bool {
+		return p.ID == prID && p.TeamID == 2
+	})).Return(prID, nil).Once()
+	mockReviewer.On("AssignReviewer", ctx, prID, "rev-s").Return(nil).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, "feat: search filters", authorID, "search", entity.PullRequestSize{}, nil, "", true)
+
+	assert.NoError(t, e)
+	assert.Equal(t, []string{"rev-s"}, result.AssignedReviewers)
+}
+
+func TestPullRequestService_Create_HomeTeamResolution(t *testing.T) {
+	teams := []*entity.Team{{ID: 1, Name: "payments"}, {ID: 2, Name: "search"}}
+
+	cases := []struct {
+		name     string
+		teams    []*entity.Team
+		teamName string
+		wantErr  error
+	}{
+		{name: "several teams without team_name", teams: teams, teamName: "", wantErr: repo.ErrTeamRequired},
+		{name: "team the author is not in", teams: teams, teamName: "mobile", wantErr: repo.ErrNotTeamMember},
+		{name: "author without teams", teams: []*entity.Team{}, teamName: "", wantErr: repo.ErrNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			authorID := "author-12"
+
+			mockPr := mocks.NewPrController(t)
+			mockUser := mocks.NewUserGetter(t)
+			mockReviewer := mocks.NewReviewerProvider(t)
+			mockTeams := mocks.NewTeamsProvider(t)
+			mockTxManager := &mocks.MockManager{}
+			mockTxManager.Test(t)
+			t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+			mockUser.On("GetById", ctx, authorID).Return(&entity.User{ID: authorID}, nil).Once()
+			mockTeams.On("GetTeamsByUserID", ctx, authorID).Return(tc.teams, nil).Once()
+
+			mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+				Run(func(args mock.Arguments) {
+					fn := args.Get(1).(func(context.Context) error)
+					assert.ErrorIs(t, fn(ctx), tc.wantErr)
+				}).Return(tc.wantErr).Once()
+
+			service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+			result, e := service.Create(ctx, "pr-epsilon", "feat: something", authorID, tc.teamName, entity.PullRequestSize{}, nil, "", true)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, e, tc.wantErr)
+			mockPr.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestPullRequestService_Merge_Success_FromOpen(t *testing.T) {
 	ctx := context.Background()
 	prID := "merge-req-1"
@@ -163,10 +257,13 @@ func TestPullRequestService_Merge_Success_FromOpen(t *testing.T) {
 			assert.NoError(t, fn(ctx))
 		}).Return(nil).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil)
+	merged := testutil.ToFloat64(metrics.PullRequestsMerged)
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
 	result, e := service.Merge(ctx, prID)
 
 	assert.NoError(t, e)
+	assert.Equal(t, merged+1, testutil.ToFloat64(metrics.PullRequestsMerged))
 	assert.NotNil(t, result)
 	assert.Equal(t, prID, result.ID)
 	assert.Equal(t, "docs: update README", result.Name)
@@ -205,10 +302,13 @@ func TestPullRequestService_Merge_Success_AlreadyMerged_NoMarkCall(t *testing.T)
 			assert.NoError(t, fn(ctx))
 		}).Return(nil).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil)
+	merged := testutil.ToFloat64(metrics.PullRequestsMerged)
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
 	result, e := service.Merge(ctx, prID)
 
 	assert.NoError(t, e)
+	assert.Equal(t, merged, testutil.ToFloat64(metrics.PullRequestsMerged))
 	assert.NotNil(t, result)
 	assert.Equal(t, pr.StatusMerged, result.Status)
 	assert.Equal(t, reviewerIDs, result.AssignedReviewers)
@@ -236,7 +336,7 @@ func TestPullRequestService_Merge_Error_FirstGetById(t *testing.T) {
 			assert.Equal(t, getError, e)
 		}).Return(getError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
 	result, e := service.Merge(ctx, prID)
 
 	assert.Nil(t, result)
@@ -269,7 +369,7 @@ func TestPullRequestService_Merge_Error_SecondGetById(t *testing.T) {
 			assert.Equal(t, secondError, e)
 		}).Return(secondError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
 	result, e := service.Merge(ctx, prID)
 
 	assert.Nil(t, result)
@@ -302,7 +402,7 @@ func TestPullRequestService_Merge_Error_GetReviewers(t *testing.T) {
 			assert.Equal(t, reviewerError, e)
 		}).Return(reviewerError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
 	result, e := service.Merge(ctx, prID)
 
 	assert.Nil(t, result)
@@ -310,9 +410,9 @@ func TestPullRequestService_Merge_Error_GetReviewers(t *testing.T) {
 	assert.Equal(t, reviewerError, e)
 }
 
-func TestPullRequestService_Merge_Ignores_MarkAsMergedError(t *testing.T) {
+func TestPullRequestService_Merge_MarkAsMergedError(t *testing.T) {
 	ctx := context.Background()
-	prID := "merge-ignore-1"
+	prID := "merge-fail-1"
 
 	mockPr := mocks.NewPrController(t)
 	mockReviewer := mocks.NewReviewerProvider(t)
@@ -321,28 +421,24 @@ func TestPullRequestService_Merge_Ignores_MarkAsMergedError(t *testing.T) {
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
 	openPR := &entity.PullRequest{ID: prID, Title: "Some Title", AuthorId: "author-a", Status: pr.StatusOpen}
-	mergedPR := &entity.PullRequest{ID: prID, Title: "Some Title", AuthorId: "author-a", Status: pr.StatusMerged}
-	reviewerIDs := []string{"reviewer-r1"}
 	mergeError := errors.New("could not mark as merged")
 
 	mockPr.On("GetById", ctx, prID).Return(openPR, nil).Once()
 	mockPr.On("MarkAsMerged", ctx, prID).Return(mergeError).Once()
-	mockPr.On("GetById", ctx, prID).Return(mergedPR, nil).Once()
-	mockReviewer.On("GetPrReviewers", ctx, prID).Return(reviewerIDs, nil).Once()
 
+	// the error has to reach the transaction so that it rolls back
 	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
 			fn := args.Get(1).(func(context.Context) error)
-			assert.NoError(t, fn(ctx))
-		}).Return(nil).Once()
+			assert.ErrorIs(t, fn(ctx), mergeError)
+		}).Return(mergeError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
 	result, e := service.Merge(ctx, prID)
 
-	assert.NoError(t, e)
-	assert.NotNil(t, result)
-	assert.Equal(t, pr.StatusMerged, result.Status)
-	assert.Equal(t, reviewerIDs, result.AssignedReviewers)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, e, mergeError)
+	mockReviewer.AssertNotCalled(t, "GetPrReviewers", mock.Anything, mock.Anything)
 }
 
 func TestPullRequestService_Reassign_Success(t *testing.T) {
@@ -357,17 +453,15 @@ func TestPullRequestService_Reassign_Success(t *testing.T) {
 	mockTxManager.Test(t)
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
-	currentPR := &entity.PullRequest{ID: prID, Title: "refactor: improve performance", AuthorId: "author-a", Status: pr.StatusOpen}
-	authorUser := &entity.User{ID: "author-a", TeamID: 777}
+	currentPR := &entity.PullRequest{ID: prID, Title: "refactor: improve performance", AuthorId: "author-a", TeamID: 777, Status: pr.StatusOpen}
 	activeIDs := []string{"author-a", "reviewer-r1", "reviewer-r2", "reviewer-r3"}
 	assignedIDs := []string{"reviewer-r1", "reviewer-r2"}
 	finalIDs := []string{"reviewer-r2", "reviewer-r3"}
 
 	mockPr.On("GetById", ctx, prID).Return(currentPR, nil).Twice()
-	mockUser.On("GetById", ctx, "author-a").Return(authorUser, nil).Once()
 	mockUser.On("GetActiveUsersIDInTeam", ctx, 777).Return(activeIDs, nil).Once()
 	mockReviewer.On("GetPrReviewers", ctx, prID).Return(assignedIDs, nil).Once()
-	mockReviewer.On("ReassignReviewer", ctx, prID, oldRev, mock.AnythingOfType("string")).Return(nil).Once()
+	mockReviewer.On("ReassignReviewer", ctx, prID, oldRev, mock.AnythingOfType("string"), entity.UnassignReassigned).Return(nil).Once()
 	mockReviewer.On("GetPrReviewers", ctx, prID).Return(finalIDs, nil).Once()
 
 	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
@@ -376,7 +470,7 @@ func TestPullRequestService_Reassign_Success(t *testing.T) {
 			assert.NoError(t, fn(ctx))
 		}).Return(nil).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, nil, pr.MergePolicyBlock)
 	result, e := service.Reassign(ctx, prID, oldRev)
 
 	assert.NoError(t, e)
@@ -402,13 +496,11 @@ func TestPullRequestService_Reassign_NoCandidate(t *testing.T) {
 	mockTxManager.Test(t)
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
-	currentPR := &entity.PullRequest{ID: prID, Title: "fix: alignment issue", AuthorId: "author-a", Status: pr.StatusOpen}
-	authorUser := &entity.User{ID: "author-a", TeamID: 55}
+	currentPR := &entity.PullRequest{ID: prID, Title: "fix: alignment issue", AuthorId: "author-a", TeamID: 55, Status: pr.StatusOpen}
 	activeIDs := []string{"author-a", "busy-reviewer"}
 	assignedIDs := []string{"busy-reviewer"}
 
 	mockPr.On("GetById", ctx, prID).Return(currentPR, nil).Once()
-	mockUser.On("GetById", ctx, "author-a").Return(authorUser, nil).Once()
 	mockUser.On("GetActiveUsersIDInTeam", ctx, 55).Return(activeIDs, nil).Once()
 	mockReviewer.On("GetPrReviewers", ctx, prID).Return(assignedIDs, nil).Once()
 
@@ -420,13 +512,21 @@ func TestPullRequestService_Reassign_NoCandidate(t *testing.T) {
 			assert.Equal(t, repo.ErrNoCandidate, e)
 		}).Return(repo.ErrNoCandidate).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
-	result, e := service.Reassign(ctx, prID, oldRev)
+	noCandidate := testutil.ToFloat64(metrics.NoCandidate.WithLabelValues(metrics.OperationReassign))
+
+	prService := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, nil, pr.MergePolicyBlock)
+	result, e := prService.Reassign(ctx, prID, oldRev)
 
 	assert.Nil(t, result)
-	assert.Error(t, e)
-	assert.Equal(t, repo.ErrNoCandidate, e)
-	mockReviewer.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	assert.ErrorIs(t, e, repo.ErrNoCandidate)
+	domainErr := service.AsError(e)
+	if assert.NotNil(t, domainErr) {
+		assert.Equal(t, dto.ErrCodeNoCandidate, domainErr.Code)
+		assert.Equal(t, http.StatusConflict, domainErr.Status)
+		assert.Equal(t, map[string]any{"pull_request_id": prID, "old_reviewer_id": oldRev}, domainErr.Details)
+	}
+	assert.Equal(t, noCandidate+1, testutil.ToFloat64(metrics.NoCandidate.WithLabelValues(metrics.OperationReassign)))
+	mockReviewer.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestPullRequestService_Reassign_ReassignReviewerError(t *testing.T) {
@@ -441,17 +541,15 @@ func TestPullRequestService_Reassign_ReassignReviewerError(t *testing.T) {
 	mockTxManager.Test(t)
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
-	currentPR := &entity.PullRequest{ID: prID, Title: "hotfix: critical security patch", AuthorId: "author-a", Status: pr.StatusOpen}
-	authorUser := &entity.User{ID: "author-a", TeamID: 33}
+	currentPR := &entity.PullRequest{ID: prID, Title: "hotfix: critical security patch", AuthorId: "author-a", TeamID: 33, Status: pr.StatusOpen}
 	activeIDs := []string{"author-a", "reviewer-r1", "reviewer-r2"}
 	assignedIDs := []string{"reviewer-r1"}
 	reassignError := errors.New("could not reassign")
 
 	mockPr.On("GetById", ctx, prID).Return(currentPR, nil).Once()
-	mockUser.On("GetById", ctx, "author-a").Return(authorUser, nil).Once()
 	mockUser.On("GetActiveUsersIDInTeam", ctx, 33).Return(activeIDs, nil).Once()
 	mockReviewer.On("GetPrReviewers", ctx, prID).Return(assignedIDs, nil).Once()
-	mockReviewer.On("ReassignReviewer", ctx, prID, oldRev, mock.AnythingOfType("string")).Return(reassignError).Once()
+	mockReviewer.On("ReassignReviewer", ctx, prID, oldRev, mock.AnythingOfType("string"), entity.UnassignReassigned).Return(reassignError).Once()
 
 	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -461,7 +559,7 @@ func TestPullRequestService_Reassign_ReassignReviewerError(t *testing.T) {
 			assert.Equal(t, reassignError, e)
 		}).Return(reassignError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, nil, pr.MergePolicyBlock)
 	result, e := service.Reassign(ctx, prID, oldRev)
 
 	assert.Nil(t, result)
@@ -492,7 +590,7 @@ func TestPullRequestService_Reassign_GetByIdError(t *testing.T) {
 			assert.Equal(t, getError, e)
 		}).Return(getError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, nil, pr.MergePolicyBlock)
 	result, e := service.Reassign(ctx, prID, oldRev)
 
 	assert.Nil(t, result)
@@ -512,12 +610,10 @@ func TestPullRequestService_Reassign_GetActiveUsersError(t *testing.T) {
 	mockTxManager.Test(t)
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
-	currentPR := &entity.PullRequest{ID: prID, Title: "bug: active user lookup", AuthorId: "author-a", Status: pr.StatusOpen}
-	authorUser := &entity.User{ID: "author-a", TeamID: 113}
+	currentPR := &entity.PullRequest{ID: prID, Title: "bug: active user lookup", AuthorId: "author-a", TeamID: 113, Status: pr.StatusOpen}
 	activeUsersError := errors.New("user service is down")
 
 	mockPr.On("GetById", ctx, prID).Return(currentPR, nil).Once()
-	mockUser.On("GetById", ctx, "author-a").Return(authorUser, nil).Once()
 	mockUser.On("GetActiveUsersIDInTeam", ctx, 113).Return(([]string)(nil), activeUsersError).Once()
 
 	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Run(func(args mock.Arguments) {
@@ -527,7 +623,7 @@ func TestPullRequestService_Reassign_GetActiveUsersError(t *testing.T) {
 		assert.Equal(t, activeUsersError, e)
 	}).Return(activeUsersError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, nil, pr.MergePolicyBlock)
 	result, e := service.Reassign(ctx, prID, oldRev)
 
 	assert.Nil(t, result)
@@ -547,12 +643,10 @@ func TestPullRequestService_Reassign_GetReviewersError(t *testing.T) {
 	mockTxManager.Test(t)
 	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
 
-	currentPR := &entity.PullRequest{ID: prID, Title: "bug: reviewer lookup", AuthorId: "author-a", Status: pr.StatusOpen}
-	authorUser := &entity.User{ID: "author-a", TeamID: 111}
+	currentPR := &entity.PullRequest{ID: prID, Title: "bug: reviewer lookup", AuthorId: "author-a", TeamID: 111, Status: pr.StatusOpen}
 	reviewerError := errors.New("reviewer service is down")
 
 	mockPr.On("GetById", ctx, prID).Return(currentPR, nil).Once()
-	mockUser.On("GetById", ctx, "author-a").Return(authorUser, nil).Once()
 	mockUser.On("GetActiveUsersIDInTeam", ctx, 111).Return([]string{"author-a", "reviewer-r1", "reviewer-r2"}, nil).Once()
 	mockReviewer.On("GetPrReviewers", ctx, prID).Return(([]string)(nil), reviewerError).Once()
 
@@ -563,10 +657,583 @@ func TestPullRequestService_Reassign_GetReviewersError(t *testing.T) {
 		assert.Equal(t, reviewerError, e)
 	}).Return(reviewerError).Once()
 
-	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser)
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, nil, pr.MergePolicyBlock)
 	result, e := service.Reassign(ctx, prID, oldRev)
 
 	assert.Nil(t, result)
 	assert.Error(t, e)
 	assert.Equal(t, reviewerError, e)
 }
+
+func TestPullRequestService_Get_Success(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-get"
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	mockPr := mocks.NewPrController(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	mockPr.On("GetById", ctx, prID).Return(&entity.PullRequest{
+		ID: prID, Title: "feat: search", AuthorId: "u1", Status: pr.StatusOpen, CreatedAt: &createdAt,
+	}, nil).Once()
+	mockReviewer.On("GetPrReviewers", ctx, prID).Return([]string{"u2", "u3"}, nil).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
+	result, e := service.Get(ctx, prID)
+
+	assert.NoError(t, e)
+	assert.Equal(t, prID, result.ID)
+	assert.Equal(t, []string{"u2", "u3"}, result.AssignedReviewers)
+	assert.Equal(t, &createdAt, result.CreatedAt)
+}
+
+func TestPullRequestService_Get_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mockPr := mocks.NewPrController(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	mockPr.On("GetById", ctx, "missing").Return(nil, repo.ErrNotFound).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.ErrorIs(t, fn(ctx), repo.ErrNotFound)
+		}).Return(repo.ErrNotFound).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
+	result, e := service.Get(ctx, "missing")
+
+	assert.ErrorIs(t, e, repo.ErrNotFound)
+	assert.Nil(t, result)
+}
+
+func TestPullRequestService_List_ReturnsNextCursor(t *testing.T) {
+	ctx := context.Background()
+	first := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	mockPr := mocks.NewPrController(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	filter := entity.PullRequestFilter{Status: pr.StatusOpen, SortBy: entity.PullRequestSortCreatedAt, Limit: 2}
+	expectedFilter := filter
+	expectedFilter.Limit = 3
+
+	mockPr.On("List", ctx, expectedFilter).Return([]*entity.PullRequest{
+		{ID: "pr-1", Status: pr.StatusOpen, CreatedAt: &first},
+		{ID: "pr-2", Status: pr.StatusOpen, CreatedAt: &second},
+		{ID: "pr-3", Status: pr.StatusOpen, CreatedAt: &second},
+	}, nil).Once()
+	mockReviewer.On("GetReviewersByPrIDs", ctx, []string{"pr-1", "pr-2"}).
+		Return(map[string][]string{"pr-1": {"u2"}}, nil).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
+	result, e := service.List(ctx, filter)
+
+	assert.NoError(t, e)
+	assert.Len(t, result.PullRequests, 2)
+	assert.Equal(t, []string{"u2"}, result.PullRequests[0].AssignedReviewers)
+	assert.Empty(t, result.PullRequests[1].AssignedReviewers)
+
+	cursor, err := dto.DecodeCursor(result.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, "pr-2", cursor.ID)
+	assert.Equal(t, second.Format(time.RFC3339Nano), cursor.Value)
+}
+
+func TestPullRequestService_Update_MergedKeepsReviewers(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-update"
+	title := "feat: better search"
+	size := 120
+	mergedAt := time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	mockPr := mocks.NewPrController(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	expectedUpd := entity.PullRequestUpdate{Title: &title, Labels: []string{"backend", "db"}, Size: &size}
+	mockPr.On("Update", ctx, prID, expectedUpd).Return(nil).Once()
+	mockPr.On("SetLabels", ctx, prID, []string{"backend", "db"}).Return(nil).Once()
+	mockPr.On("GetById", ctx, prID).Return(&entity.PullRequest{
+		ID: prID, Title: title, AuthorId: "u1", Status: pr.StatusMerged, MergedAt: &mergedAt,
+		Labels: []string{"backend", "db"}, Size: size,
+	}, nil).Once()
+	mockReviewer.On("GetPrReviewers", ctx, prID).Return([]string{"u2"}, nil).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
+	result, e := service.Update(ctx, prID, entity.PullRequestUpdate{
+		Title:  &title,
+		Labels: []string{" backend", "db", "backend", ""},
+		Size:   &size,
+	})
+
+	assert.NoError(t, e)
+	assert.Equal(t, title, result.Name)
+	assert.Equal(t, []string{"backend", "db"}, result.Labels)
+	assert.Equal(t, size, result.Size)
+	assert.Equal(t, []string{"u2"}, result.AssignedReviewers)
+	mockReviewer.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockReviewer.AssertNotCalled(t, "AssignReviewer", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPullRequestService_Update_NotFound(t *testing.T) {
+	ctx := context.Background()
+	title := "feat: better search"
+
+	mockPr := mocks.NewPrController(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	upd := entity.PullRequestUpdate{Title: &title}
+	mockPr.On("Update", ctx, "missing", upd).Return(repo.ErrNotFound).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.ErrorIs(t, fn(ctx), repo.ErrNotFound)
+		}).Return(repo.ErrNotFound).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
+	result, e := service.Update(ctx, "missing", upd)
+
+	assert.ErrorIs(t, e, repo.ErrNotFound)
+	assert.Nil(t, result)
+}
+
+func TestPullRequestService_Create_LargePrUsesSeniorTier(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-large"
+	authorID := "author-10"
+	teamID := 100
+	additions, deletions := 700, 100
+	small, medium := 50, 500
+
+	mockPr := mocks.NewPrController(t)
+	mockUser := mocks.NewUserGetter(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	mockUser.On("GetById", ctx, authorID).Return(&entity.User{ID: authorID}, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
+	mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).Return([]string{authorID, "junior-1", "junior-2", "senior-1"}, nil).Once()
+	mockTeams.On("GetSizeTiers", ctx, teamID).Return([]*entity.SizeTier{
+		{TeamID: teamID, MaxLines: &small, Reviewers: 1},
+		{TeamID: teamID, MaxLines: &medium, Reviewers: 2},
+		{TeamID: teamID, Reviewers: 3, SeniorReviewers: 1},
+	}, nil).Once()
+	mockUser.On("GetActiveSeniorsIDInTeam", ctx, teamID).Return([]string{"senior-1"}, nil).Once()
+	mockPr.On("Create", ctx, mock.MatchedBy(func(p *entity.PullRequest) bool {
+		return p.Size == 800 && *p.Additions == additions && *p.Deletions == deletions
+	})).Return(prID, nil).Once()
+	mockReviewer.On("AssignReviewer", ctx, prID, mock.AnythingOfType("string")).Return(nil).Times(3)
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, "feat: big rewrite", authorID, "", entity.PullRequestSize{
+		Additions: &additions,
+		Deletions: &deletions,
+	}, nil, "", true)
+
+	assert.NoError(t, e)
+	assert.Equal(t, "senior-1", result.AssignedReviewers[0])
+	assert.ElementsMatch(t, []string{"senior-1", "junior-1", "junior-2"}, result.AssignedReviewers)
+	assert.Equal(t, 800, result.Size)
+}
+
+func TestPullRequestService_Create_TinyPrGetsOneReviewer(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-tiny"
+	authorID := "author-10"
+	teamID := 100
+	additions := 3
+	small := 50
+
+	mockPr := mocks.NewPrController(t)
+	mockUser := mocks.NewUserGetter(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	mockUser.On("GetById", ctx, authorID).Return(&entity.User{ID: authorID}, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
+	mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).Return([]string{authorID, "rev-1", "rev-2", "rev-3"}, nil).Once()
+	mockTeams.On("GetSizeTiers", ctx, teamID).Return([]*entity.SizeTier{
+		{TeamID: teamID, MaxLines: &small, Reviewers: 1},
+	}, nil).Once()
+	mockPr.On("Create", ctx, mock.AnythingOfType("*entity.PullRequest")).Return(prID, nil).Once()
+	mockReviewer.On("AssignReviewer", ctx, prID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, "fix: typo in docs", authorID, "", entity.PullRequestSize{Additions: &additions}, nil, "", true)
+
+	assert.NoError(t, e)
+	assert.Len(t, result.AssignedReviewers, 1)
+	mockUser.AssertNotCalled(t, "GetActiveSeniorsIDInTeam", mock.Anything, mock.Anything)
+}
+
+func TestPullRequestService_Create_LabelRulesAddTargetTeamMember(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-auth"
+	authorID := "author-10"
+	teamID, appsecID := 100, 200
+
+	mockPr := mocks.NewPrController(t)
+	mockUser := mocks.NewUserGetter(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	mockUser.On("GetById", ctx, authorID).Return(&entity.User{ID: authorID}, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
+	mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).Return([]string{authorID, "rev-1", "rev-2"}, nil).Once()
+	mockTeams.On("GetRoutingRules", ctx, teamID).Return([]*entity.RoutingRule{
+		{TeamID: teamID, Label: "security", Action: entity.RuleActionAddTeamMember, TargetTeamID: &appsecID},
+		{TeamID: teamID, Label: "docs", Action: entity.RuleActionAddTeamMember, TargetTeamID: &appsecID},
+	}, nil).Once()
+	mockUser.On("GetActiveUsersIDInTeam", ctx, appsecID).Return([]string{"sec-1"}, nil).Once()
+	mockPr.On("Create", ctx, mock.AnythingOfType("*entity.PullRequest")).Return(prID, nil).Once()
+	mockPr.On("SetLabels", ctx, prID, []string{"security", "backend"}).Return(nil).Once()
+	mockReviewer.On("AssignReviewer", ctx, prID, mock.AnythingOfType("string")).Return(nil).Times(3)
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, "feat: token rotation", authorID, "", entity.PullRequestSize{},
+		[]string{"security", " backend", "security"}, "", true)
+
+	assert.NoError(t, e)
+	assert.ElementsMatch(t, []string{"rev-1", "rev-2", "sec-1"}, result.AssignedReviewers)
+	assert.Equal(t, []string{"security", "backend"}, result.Labels)
+}
+
+func TestPullRequestService_Create_LabelRuleOverridesReviewers(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-hotfix"
+	authorID := "author-10"
+	teamID := 100
+	one, three := 1, 3
+
+	mockPr := mocks.NewPrController(t)
+	mockUser := mocks.NewUserGetter(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	mockUser.On("GetById", ctx, authorID).Return(&entity.User{ID: authorID}, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
+	mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).Return([]string{authorID, "rev-1", "rev-2", "rev-3"}, nil).Once()
+	mockTeams.On("GetRoutingRules", ctx, teamID).Return([]*entity.RoutingRule{
+		{TeamID: teamID, Label: "hotfix", Action: entity.RuleActionSetReviewers, Reviewers: &one},
+		{TeamID: teamID, Label: "migration", Action: entity.RuleActionSetReviewers, Reviewers: &three},
+	}, nil).Once()
+	mockPr.On("Create", ctx, mock.AnythingOfType("*entity.PullRequest")).Return(prID, nil).Once()
+	mockPr.On("SetLabels", ctx, prID, []string{"hotfix"}).Return(nil).Once()
+	mockReviewer.On("AssignReviewer", ctx, prID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, "fix: broken login", authorID, "", entity.PullRequestSize{}, []string{"hotfix"}, "", true)
+
+	assert.NoError(t, e)
+	assert.Len(t, result.AssignedReviewers, 1)
+}
+
+func TestPullRequestService_Update_NewLabelOnOpenPrAddsReviewer(t *testing.T) {
+	ctx := context.Background()
+	prID := "pr-open"
+	teamID, appsecID := 100, 200
+
+	mockPr := mocks.NewPrController(t)
+	mockUser := mocks.NewUserGetter(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	upd := entity.PullRequestUpdate{Labels: []string{"security"}}
+	mockPr.On("Update", ctx, prID, upd).Return(nil).Once()
+	mockPr.On("SetLabels", ctx, prID, []string{"security"}).Return(nil).Once()
+	mockPr.On("GetById", ctx, prID).Return(&entity.PullRequest{
+		ID: prID, AuthorId: "u1", TeamID: teamID, Status: pr.StatusOpen, Labels: []string{"security"},
+	}, nil).Once()
+	mockReviewer.On("GetPrReviewers", ctx, prID).Return([]string{"u2", "u3"}, nil).Once()
+	mockTeams.On("GetRoutingRules", ctx, teamID).Return([]*entity.RoutingRule{
+		{TeamID: teamID, Label: "security", Action: entity.RuleActionAddTeamMember, TargetTeamID: &appsecID},
+	}, nil).Once()
+	mockUser.On("GetActiveUsersIDInTeam", ctx, appsecID).Return([]string{"sec-1"}, nil).Once()
+	mockReviewer.On("AssignReviewer", ctx, prID, "sec-1").Return(nil).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Update(ctx, prID, upd)
+
+	assert.NoError(t, e)
+	assert.Equal(t, []string{"u2", "u3", "sec-1"}, result.AssignedReviewers)
+}
+
+func TestPullRequestService_Create_StackedInheritsParentReviewers(t *testing.T) {
+	ctx := context.Background()
+	prID, parentID := "pr-child", "pr-parent"
+	authorID := "author-10"
+	teamID := 100
+
+	mockPr := mocks.NewPrController(t)
+	mockUser := mocks.NewUserGetter(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTeams := mocks.NewTeamsProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	mockUser.On("GetById", ctx, authorID).Return(&entity.User{ID: authorID}, nil).Once()
+	mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
+	mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).Return([]string{authorID, "rev-1", "rev-2", "rev-3"}, nil).Once()
+	mockPr.On("GetById", ctx, parentID).Return(&entity.PullRequest{ID: parentID, Status: pr.StatusOpen}, nil).Once()
+	// rev-gone left the team, its seat goes to someone else
+	mockReviewer.On("GetPrReviewers", ctx, parentID).Return([]string{"rev-1", "rev-gone"}, nil).Once()
+	mockPr.On("Create", ctx, mock.MatchedBy(func(p *entity.PullRequest) bool {
+		return p.ParentID != nil && *p.ParentID == parentID
+	})).Return(prID, nil).Once()
+	mockReviewer.On("AssignReviewer", ctx, prID, mock.AnythingOfType("string")).Return(nil).Twice()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+	result, e := service.Create(ctx, prID, "feat: part two", authorID, "", entity.PullRequestSize{}, nil, parentID, true)
+
+	assert.NoError(t, e)
+	assert.Len(t, result.AssignedReviewers, 2)
+	assert.Equal(t, "rev-1", result.AssignedReviewers[0])
+	assert.NotContains(t, result.AssignedReviewers, "rev-gone")
+	assert.Equal(t, parentID, *result.ParentID)
+}
+
+func TestPullRequestService_Create_StackedFollowsChildTier(t *testing.T) {
+	tests := []struct {
+		name      string
+		tier      *entity.SizeTier
+		seniorIDs []string
+		want      []string
+	}{
+		{
+			name: "inherited reviewers are capped at the tier",
+			tier: &entity.SizeTier{Reviewers: 1},
+			want: []string{"junior-1"},
+		},
+		{
+			name:      "missing senior replaces an inherited junior",
+			tier:      &entity.SizeTier{Reviewers: 2, SeniorReviewers: 1},
+			seniorIDs: []string{"senior-1"},
+			want:      []string{"junior-1", "senior-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			prID, parentID := "pr-child", "pr-parent"
+			authorID := "author-10"
+			teamID := 100
+			additions := 10
+
+			mockPr := mocks.NewPrController(t)
+			mockUser := mocks.NewUserGetter(t)
+			mockReviewer := mocks.NewReviewerProvider(t)
+			mockTeams := mocks.NewTeamsProvider(t)
+			mockTxManager := &mocks.MockManager{}
+			mockTxManager.Test(t)
+			t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+			tt.tier.TeamID = teamID
+			mockUser.On("GetById", ctx, authorID).Return(&entity.User{ID: authorID}, nil).Once()
+			mockTeams.On("GetTeamsByUserID", ctx, authorID).Return([]*entity.Team{{ID: teamID, Name: "core"}}, nil).Once()
+			mockUser.On("GetActiveUsersIDInTeam", ctx, teamID).
+				Return([]string{authorID, "junior-1", "junior-2", "junior-3", "senior-1"}, nil).Once()
+			mockTeams.On("GetSizeTiers", ctx, teamID).Return([]*entity.SizeTier{tt.tier}, nil).Once()
+			if tt.tier.SeniorReviewers > 0 {
+				mockUser.On("GetActiveSeniorsIDInTeam", ctx, teamID).Return(tt.seniorIDs, nil).Once()
+			}
+			mockPr.On("GetById", ctx, parentID).Return(&entity.PullRequest{ID: parentID, Status: pr.StatusOpen}, nil).Once()
+			mockReviewer.On("GetPrReviewers", ctx, parentID).Return([]string{"junior-1", "junior-2", "junior-3"}, nil).Once()
+			mockPr.On("Create", ctx, mock.AnythingOfType("*entity.PullRequest")).Return(prID, nil).Once()
+			mockReviewer.On("AssignReviewer", ctx, prID, mock.AnythingOfType("string")).Return(nil).Times(len(tt.want))
+
+			mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+				Run(func(args mock.Arguments) {
+					fn := args.Get(1).(func(context.Context) error)
+					assert.NoError(t, fn(ctx))
+				}).Return(nil).Once()
+
+			service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, mockUser, mockTeams, pr.MergePolicyBlock)
+			result, e := service.Create(ctx, prID, "feat: part two", authorID, "",
+				entity.PullRequestSize{Additions: &additions}, nil, parentID, true)
+
+			assert.NoError(t, e)
+			assert.Equal(t, tt.want, result.AssignedReviewers)
+			assert.Zero(t, result.PendingReviewers)
+		})
+	}
+}
+
+func TestPullRequestService_Merge_ChildBeforeParent(t *testing.T) {
+	ctx := context.Background()
+	prID, parentID := "pr-child", "pr-parent"
+	child := &entity.PullRequest{ID: prID, Status: pr.StatusOpen, ParentID: &parentID}
+	parent := &entity.PullRequest{ID: parentID, Status: pr.StatusOpen}
+
+	t.Run("blocked", func(t *testing.T) {
+		mockPr := mocks.NewPrController(t)
+		mockTxManager := &mocks.MockManager{}
+		mockTxManager.Test(t)
+		t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+		mockPr.On("GetById", ctx, prID).Return(child, nil).Once()
+		mockPr.On("GetById", ctx, parentID).Return(parent, nil).Once()
+
+		mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(context.Context) error)
+				assert.ErrorIs(t, fn(ctx), repo.ErrParentNotMerged)
+			}).Return(repo.ErrParentNotMerged).Once()
+
+		service := pr.NewPullRequestService(mockTxManager, mockPr, nil, nil, nil, pr.MergePolicyBlock)
+		result, e := service.Merge(ctx, prID)
+
+		assert.ErrorIs(t, e, repo.ErrParentNotMerged)
+		assert.Nil(t, result)
+		mockPr.AssertNotCalled(t, "MarkAsMerged", mock.Anything, mock.Anything)
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		mockPr := mocks.NewPrController(t)
+		mockReviewer := mocks.NewReviewerProvider(t)
+		mockTxManager := &mocks.MockManager{}
+		mockTxManager.Test(t)
+		t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+		mockPr.On("GetById", ctx, prID).Return(child, nil).Once()
+		mockPr.On("MarkAsMerged", ctx, prID).Return(nil).Once()
+		mockPr.On("GetById", ctx, prID).Return(&entity.PullRequest{ID: prID, Status: pr.StatusMerged, ParentID: &parentID}, nil).Once()
+		mockReviewer.On("GetPrReviewers", ctx, prID).Return([]string{}, nil).Once()
+
+		mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(context.Context) error)
+				assert.NoError(t, fn(ctx))
+			}).Return(nil).Once()
+
+		service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyAllow)
+		result, e := service.Merge(ctx, prID)
+
+		assert.NoError(t, e)
+		assert.Equal(t, pr.StatusMerged, result.Status)
+		mockPr.AssertNotCalled(t, "GetById", ctx, parentID)
+	})
+}
+
+func TestPullRequestService_Stack(t *testing.T) {
+	ctx := context.Background()
+	root, mid, leaf := "pr-1", "pr-2", "pr-3"
+
+	mockPr := mocks.NewPrController(t)
+	mockReviewer := mocks.NewReviewerProvider(t)
+	mockTxManager := &mocks.MockManager{}
+	mockTxManager.Test(t)
+	t.Cleanup(func() { mockTxManager.AssertExpectations(t) })
+
+	mockPr.On("GetStack", ctx, mid).Return([]*entity.PullRequest{
+		{ID: root, Status: pr.StatusMerged},
+		{ID: mid, Status: pr.StatusOpen, ParentID: &root},
+		{ID: leaf, Status: pr.StatusOpen, ParentID: &mid},
+	}, nil).Once()
+	mockReviewer.On("GetReviewersByPrIDs", ctx, []string{root, mid, leaf}).
+		Return(map[string][]string{mid: {"u2"}}, nil).Once()
+
+	mockTxManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).Return(nil).Once()
+
+	service := pr.NewPullRequestService(mockTxManager, mockPr, mockReviewer, nil, nil, pr.MergePolicyBlock)
+	result, e := service.Stack(ctx, mid)
+
+	assert.NoError(t, e)
+	assert.Equal(t, mid, result.PullRequestID)
+	assert.Len(t, result.PullRequests, 3)
+	assert.Equal(t, pr.StatusMerged, result.PullRequests[0].Status)
+	assert.Equal(t, []string{"u2"}, result.PullRequests[1].AssignedReviewers)
+	assert.Equal(t, mid, *result.PullRequests[2].ParentID)
+}
@@ -0,0 +1,197 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib"
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/service"
+	prservice "railgorail/avito/internal/service/pr"
+	"railgorail/avito/internal/tracing"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=PendingPrProvider
+type PendingPrProvider interface {
+	GetWithPendingReviewers(ctx context.Context) ([]*entity.PullRequest, error)
+	GetWithPendingReviewersInTeam(ctx context.Context, teamID int) ([]*entity.PullRequest, error)
+	GetById(ctx context.Context, prID string) (*entity.PullRequest, error)
+	GetPrReviewers(ctx context.Context, prID string) ([]string, error)
+	AssignReviewer(ctx context.Context, prID, userID string) error
+	SetPendingReviewers(ctx context.Context, prID string, pending int) error
+	AddEvent(ctx context.Context, event *entity.PullRequestEvent) error
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=CandidateProvider
+type CandidateProvider interface {
+	GetActiveUsersIDInTeam(ctx context.Context, teamID int) ([]string, error)
+}
+
+// wakeQueueSize bounds the teams waiting for Run, more are left to the poll.
+const wakeQueueSize = 64
+
+// ReconcilerService fills pending reviewer seats. Services call Wake when a
+// team gets a new candidate: a user is activated, joins a team or comes back
+// from a vacation. The poll in Run is only a backstop for missed wakes.
+type ReconcilerService struct {
+	log        *slog.Logger
+	trm        service.TransactionManager
+	prs        PendingPrProvider
+	candidates CandidateProvider
+	wake       chan int
+}
+
+func NewReconcilerService(log *slog.Logger, trm service.TransactionManager, prs PendingPrProvider, candidates CandidateProvider) *ReconcilerService {
+	return &ReconcilerService{
+		log:        log,
+		trm:        trm,
+		prs:        prs,
+		candidates: candidates,
+		wake:       make(chan int, wakeQueueSize),
+	}
+}
+
+// Wake asks Run to fill pending seats of the teams' PRs. It never blocks,
+// call it after the change that made candidates available is committed.
+func (s *ReconcilerService) Wake(teamIDs ...int) {
+	for _, id := range teamIDs {
+		select {
+		case s.wake <- id:
+		default:
+		}
+	}
+}
+
+// Reconcile makes one pass over open PRs with pending seats and returns how
+// many seats it filled. A failing PR does not stop the pass.
+func (s *ReconcilerService) Reconcile(ctx context.Context) (int, error) {
+	ctx, span := tracing.Start(ctx, "ReconcilerService.Reconcile")
+	defer span.End()
+
+	prs, err := s.prs.GetWithPendingReviewers(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.fillAll(ctx, prs), nil
+}
+
+// ReconcileTeam is Reconcile for the PRs of one team.
+func (s *ReconcilerService) ReconcileTeam(ctx context.Context, teamID int) (int, error) {
+	ctx, span := tracing.Start(ctx, "ReconcilerService.ReconcileTeam")
+	defer span.End()
+
+	prs, err := s.prs.GetWithPendingReviewersInTeam(ctx, teamID)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.fillAll(ctx, prs), nil
+}
+
+func (s *ReconcilerService) fillAll(ctx context.Context, prs []*entity.PullRequest) int {
+	const op = "reconciler_service.fillAll"
+	log := s.log.With(slog.String("op", op))
+
+	filled := 0
+	for _, pr := range prs {
+		n, err := s.fill(ctx, pr.ID)
+		if err != nil {
+			log.Error("failed to fill reviewer seats", slog.String("pull_request_id", pr.ID), sl.Err(err))
+			continue
+		}
+		filled += n
+	}
+
+	return filled
+}
+
+// fill re-reads the PR inside the transaction, it may have been merged or
+// filled since the pass started.
+func (s *ReconcilerService) fill(ctx context.Context, prID string) (int, error) {
+	filled := 0
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		pr, err := s.prs.GetById(ctx, prID)
+		if err != nil {
+			return err
+		}
+		if pr.Status != prservice.StatusOpen || pr.PendingReviewers == 0 {
+			return nil
+		}
+
+		active, err := s.candidates.GetActiveUsersIDInTeam(ctx, pr.TeamID)
+		if err != nil {
+			return err
+		}
+
+		current, err := s.prs.GetPrReviewers(ctx, prID)
+		if err != nil {
+			return err
+		}
+
+		excluded := append([]string{pr.AuthorId}, current...)
+		picked := lib.RandomUsers(active, pr.PendingReviewers, excluded...)
+		if len(picked) == 0 {
+			return nil
+		}
+
+		for _, userID := range picked {
+			if err := s.prs.AssignReviewer(ctx, prID, userID); err != nil {
+				return err
+			}
+
+			err := s.prs.AddEvent(ctx, &entity.PullRequestEvent{
+				PullRequestID: prID,
+				Type:          entity.PullRequestEventSlotFilled,
+				UserID:        &userID,
+				Details:       fmt.Sprintf("pending reviewer seat filled, %d left", pr.PendingReviewers-filled-1),
+			})
+			if err != nil {
+				return err
+			}
+			filled++
+		}
+
+		return s.prs.SetPendingReviewers(ctx, prID, pr.PendingReviewers-filled)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return filled, nil
+}
+
+// Run makes a full pass right away and then every interval until ctx is
+// done, teams passed to Wake are reconciled as they come.
+func (s *ReconcilerService) Run(ctx context.Context, interval time.Duration) {
+	const op = "reconciler_service.Run"
+	log := s.log.With(slog.String("op", op))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logPass := func(filled int, err error, attrs ...any) {
+		if err != nil {
+			log.Error("reviewer reconcile failed", append(attrs, sl.Err(err))...)
+		} else if filled > 0 {
+			log.Info("pending reviewer seats filled", append(attrs, slog.Int("filled", filled))...)
+		}
+	}
+
+	logPass(s.Reconcile(ctx))
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case teamID := <-s.wake:
+			filled, err := s.ReconcileTeam(ctx, teamID)
+			logPass(filled, err, slog.Int("team_id", teamID))
+		case <-ticker.C:
+			logPass(s.Reconcile(ctx))
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package reconciler_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/service/mocks"
+	"railgorail/avito/internal/service/pr"
+	"railgorail/avito/internal/service/reconciler"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var discardLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestReconcilerService_Reconcile_FillsPendingSeats(t *testing.T) {
+	ctx := context.Background()
+	teamID := 10
+
+	mockPrs := mocks.NewPendingPrProvider(t)
+	mockCandidates := mocks.NewCandidateProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	waiting := &entity.PullRequest{ID: "pr-1", AuthorId: "u1", TeamID: teamID, Status: pr.StatusOpen, PendingReviewers: 2}
+	merged := &entity.PullRequest{ID: "pr-2", AuthorId: "u1", TeamID: teamID, Status: pr.StatusMerged, PendingReviewers: 1}
+
+	mockPrs.On("GetWithPendingReviewers", ctx).Return([]*entity.PullRequest{waiting, merged}, nil).Once()
+	mockPrs.On("GetById", ctx, "pr-1").Return(waiting, nil).Once()
+	mockPrs.On("GetById", ctx, "pr-2").Return(merged, nil).Once()
+	// u3 came back from a vacation, u2 already reviews the PR
+	mockCandidates.On("GetActiveUsersIDInTeam", ctx, teamID).Return([]string{"u1", "u2", "u3"}, nil).Once()
+	mockPrs.On("GetPrReviewers", ctx, "pr-1").Return([]string{"u2"}, nil).Once()
+	mockPrs.On("AssignReviewer", ctx, "pr-1", "u3").Return(nil).Once()
+	mockPrs.On("AddEvent", ctx, mock.MatchedBy(func(e *entity.PullRequestEvent) bool {
+		return e.PullRequestID == "pr-1" && e.Type == entity.PullRequestEventSlotFilled && *e.UserID == "u3"
+	})).Return(nil).Once()
+	mockPrs.On("SetPendingReviewers", ctx, "pr-1", 1).Return(nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Twice()
+
+	svc := reconciler.NewReconcilerService(discardLog, mockTx, mockPrs, mockCandidates)
+	filled, err := svc.Reconcile(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, filled)
+	mockPrs.AssertNotCalled(t, "SetPendingReviewers", ctx, "pr-2", mock.Anything)
+}
+
+func TestReconcilerService_Reconcile_NoCandidatesKeepsSeats(t *testing.T) {
+	ctx := context.Background()
+	teamID := 10
+
+	mockPrs := mocks.NewPendingPrProvider(t)
+	mockCandidates := mocks.NewCandidateProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	waiting := &entity.PullRequest{ID: "pr-1", AuthorId: "u1", TeamID: teamID, Status: pr.StatusOpen, PendingReviewers: 1}
+
+	mockPrs.On("GetWithPendingReviewers", ctx).Return([]*entity.PullRequest{waiting}, nil).Once()
+	mockPrs.On("GetById", ctx, "pr-1").Return(waiting, nil).Once()
+	mockCandidates.On("GetActiveUsersIDInTeam", ctx, teamID).Return([]string{"u1"}, nil).Once()
+	mockPrs.On("GetPrReviewers", ctx, "pr-1").Return([]string{}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	svc := reconciler.NewReconcilerService(discardLog, mockTx, mockPrs, mockCandidates)
+	filled, err := svc.Reconcile(ctx)
+
+	assert.NoError(t, err)
+	assert.Zero(t, filled)
+	mockPrs.AssertNotCalled(t, "AssignReviewer", mock.Anything, mock.Anything, mock.Anything)
+	mockPrs.AssertNotCalled(t, "SetPendingReviewers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReconcilerService_ReconcileTeam_OnlyReadsTheTeam(t *testing.T) {
+	ctx := context.Background()
+	teamID := 10
+
+	mockPrs := mocks.NewPendingPrProvider(t)
+	mockCandidates := mocks.NewCandidateProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	waiting := &entity.PullRequest{ID: "pr-1", AuthorId: "u1", TeamID: teamID, Status: pr.StatusOpen, PendingReviewers: 1}
+
+	mockPrs.On("GetWithPendingReviewersInTeam", ctx, teamID).Return([]*entity.PullRequest{waiting}, nil).Once()
+	mockPrs.On("GetById", ctx, "pr-1").Return(waiting, nil).Once()
+	// u2 has just joined the team
+	mockCandidates.On("GetActiveUsersIDInTeam", ctx, teamID).Return([]string{"u1", "u2"}, nil).Once()
+	mockPrs.On("GetPrReviewers", ctx, "pr-1").Return([]string{}, nil).Once()
+	mockPrs.On("AssignReviewer", ctx, "pr-1", "u2").Return(nil).Once()
+	mockPrs.On("AddEvent", ctx, mock.AnythingOfType("*entity.PullRequestEvent")).Return(nil).Once()
+	mockPrs.On("SetPendingReviewers", ctx, "pr-1", 0).Return(nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	svc := reconciler.NewReconcilerService(discardLog, mockTx, mockPrs, mockCandidates)
+	filled, err := svc.ReconcileTeam(ctx, teamID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, filled)
+	mockPrs.AssertNotCalled(t, "GetWithPendingReviewers", ctx)
+}
@@ -0,0 +1,142 @@
+package stats
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/tracing"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+const (
+	LoadOver  = "over"
+	LoadUnder = "under"
+
+	// outlierDeviation is how far from the team mean, relative to it, a
+	// member's assignments per active day may go
+	outlierDeviation = 0.5
+)
+
+// GetFairness uses the window and team of the filter. Members are compared by
+// assignments per active day, so absences and joining late do not make
+// anyone look underloaded.
+func (s *StatsService) GetFairness(ctx context.Context, filter entity.StatsFilter) (*dto.FairnessResponse, error) {
+	ctx, span := tracing.Start(ctx, "StatsService.GetFairness")
+	defer span.End()
+
+	var loads []*entity.MemberLoad
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		var err error
+		loads, err = s.statsProvider.GetMemberLoad(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.FairnessResponse{Teams: []dto.TeamFairness{}}
+
+	// loads come ordered by team
+	for start := 0; start < len(loads); {
+		end := start
+		for end < len(loads) && loads[end].TeamName == loads[start].TeamName {
+			end++
+		}
+		resp.Teams = append(resp.Teams, teamFairness(loads[start].TeamName, loads[start:end]))
+		start = end
+	}
+
+	return resp, nil
+}
+
+func teamFairness(teamName string, loads []*entity.MemberLoad) dto.TeamFairness {
+	team := dto.TeamFairness{
+		TeamName: teamName,
+		Outliers: []dto.FairnessOutlier{},
+	}
+
+	var present []*entity.MemberLoad
+	rates := make([]float64, 0, len(loads))
+	for _, l := range loads {
+		team.AssignmentCount += l.AssignmentCount
+		if l.ActiveDays <= 0 {
+			continue
+		}
+		present = append(present, l)
+		rates = append(rates, float64(l.AssignmentCount)/l.ActiveDays)
+	}
+	team.Members = len(present)
+	if len(rates) == 0 {
+		return team
+	}
+
+	team.MeanRate = mean(rates)
+	team.StdDev = stdDev(rates, team.MeanRate)
+	team.Gini = gini(rates)
+	if lo, hi := slices.Min(rates), slices.Max(rates); lo > 0 {
+		ratio := hi / lo
+		team.MaxMinRatio = &ratio
+	}
+
+	if team.MeanRate == 0 {
+		return team
+	}
+	for i, l := range present {
+		var load string
+		switch {
+		case rates[i] > team.MeanRate*(1+outlierDeviation):
+			load = LoadOver
+		case rates[i] < team.MeanRate*(1-outlierDeviation):
+			load = LoadUnder
+		default:
+			continue
+		}
+		team.Outliers = append(team.Outliers, dto.FairnessOutlier{
+			UserID:          l.UserID,
+			Username:        l.Username,
+			Load:            load,
+			AssignmentCount: l.AssignmentCount,
+			Expected:        team.MeanRate * l.ActiveDays,
+			ActiveDays:      l.ActiveDays,
+		})
+	}
+
+	return team
+}
+
+func mean(xs []float64) float64 {
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// stdDev is the population standard deviation, the team is the whole population
+func stdDev(xs []float64, mean float64) float64 {
+	sum := 0.0
+	for _, x := range xs {
+		sum += (x - mean) * (x - mean)
+	}
+	return math.Sqrt(sum / float64(len(xs)))
+}
+
+// gini is 0 when everyone has the same load and approaches 1 when one member
+// has all of it.
+func gini(xs []float64) float64 {
+	sorted := slices.Sorted(slices.Values(xs))
+
+	n := float64(len(sorted))
+	var sum, weighted float64
+	for i, x := range sorted {
+		sum += x
+		weighted += float64(i+1) * x
+	}
+	if sum == 0 {
+		return 0
+	}
+	return 2*weighted/(n*sum) - (n+1)/n
+}
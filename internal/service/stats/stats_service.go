@@ -4,13 +4,21 @@ import (
 	"context"
 	"railgorail/avito/internal/entity"
 	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/tracing"
 	"railgorail/avito/internal/transport/http/dto"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=StatsProvider
 type StatsProvider interface {
-	GetAssignmentsCountStats(ctx context.Context, sort string) ([]*entity.UserStatistics, error)
-	GetPrStats(ctx context.Context) (*entity.PrStatistics, error)
+	GetAssignmentsCountStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.UserStatistics, error)
+	GetGroupedAssignmentStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.GroupStatistics, error)
+	GetPrStats(ctx context.Context, filter entity.StatsFilter) (*entity.PrStatistics, error)
+	GetTeamPrStats(ctx context.Context, filter entity.StatsFilter) ([]*entity.TeamPrStatistics, error)
+	GetMergeLatency(ctx context.Context, filter entity.StatsFilter) ([]*entity.TeamLatency, error)
+	GetReviewLatency(ctx context.Context, filter entity.StatsFilter) ([]*entity.ReviewerLatency, error)
+	GetThroughput(ctx context.Context, filter entity.StatsFilter) ([]*entity.WeeklyThroughput, error)
+	GetMemberLoad(ctx context.Context, filter entity.StatsFilter) ([]*entity.MemberLoad, error)
+	RebuildRollups(ctx context.Context) error
 }
 
 type StatsService struct {
@@ -25,27 +33,105 @@ func NewStatsService(trm service.TransactionManager, statsProvider StatsProvider
 	}
 }
 
-func (s *StatsService) GetStatistics(ctx context.Context, sort string) (*dto.StatsResponse, error) {
+func (s *StatsService) GetStatistics(ctx context.Context, filter entity.StatsFilter) (*dto.StatsResponse, error) {
+	ctx, span := tracing.Start(ctx, "StatsService.GetStatistics")
+	defer span.End()
 
 	resp := &dto.StatsResponse{
-		User: []dto.UserStats{},
+		User:  []dto.UserStats{},
+		Teams: []dto.TeamPrStats{},
 	}
 
 	err := s.trm.Do(ctx, func(ctx context.Context) error {
-		userStats, err := s.statsProvider.GetAssignmentsCountStats(ctx, sort)
+		if filter.GroupBy == "" || filter.GroupBy == entity.StatsGroupByUser {
+			userStats, err := s.statsProvider.GetAssignmentsCountStats(ctx, filter)
+			if err != nil {
+				return err
+			}
+			for _, u := range userStats {
+				stat := dto.UserStats(*u)
+				resp.User = append(resp.User, stat)
+			}
+		} else {
+			groupStats, err := s.statsProvider.GetGroupedAssignmentStats(ctx, filter)
+			if err != nil {
+				return err
+			}
+			resp.Groups = make([]dto.GroupStats, 0, len(groupStats))
+			for _, g := range groupStats {
+				resp.Groups = append(resp.Groups, dto.GroupStats(*g))
+			}
+		}
+
+		prStats, err := s.statsProvider.GetPrStats(ctx, filter)
+		if err != nil {
+			return err
+		}
+		resp.Pr = dto.PrStats(*prStats)
+
+		teamStats, err := s.statsProvider.GetTeamPrStats(ctx, filter)
+		if err != nil {
+			return err
+		}
+		for _, t := range teamStats {
+			resp.Teams = append(resp.Teams, dto.TeamPrStats{
+				TeamName: t.TeamName,
+				PrStats:  dto.PrStats(t.PrStatistics),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetLatency uses the window and team of the filter, sort and grouping are
+// ignored.
+func (s *StatsService) GetLatency(ctx context.Context, filter entity.StatsFilter) (*dto.LatencyResponse, error) {
+	ctx, span := tracing.Start(ctx, "StatsService.GetLatency")
+	defer span.End()
+
+	resp := &dto.LatencyResponse{
+		TimeToMerge:  []dto.TeamLatency{},
+		TimeInReview: []dto.ReviewerLatency{},
+		Throughput:   []dto.WeeklyThroughput{},
+	}
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		teams, err := s.statsProvider.GetMergeLatency(ctx, filter)
 		if err != nil {
 			return err
 		}
-		prStats, err := s.statsProvider.GetPrStats(ctx)
+		for _, t := range teams {
+			resp.TimeToMerge = append(resp.TimeToMerge, dto.TeamLatency{
+				TeamName:    t.TeamName,
+				Percentiles: dto.Percentiles(t.Percentiles),
+			})
+		}
+
+		reviewers, err := s.statsProvider.GetReviewLatency(ctx, filter)
 		if err != nil {
 			return err
 		}
+		for _, r := range reviewers {
+			resp.TimeInReview = append(resp.TimeInReview, dto.ReviewerLatency{
+				UserID:      r.UserID,
+				Username:    r.Username,
+				Percentiles: dto.Percentiles(r.Percentiles),
+			})
+		}
 
-		for _, u := range userStats {
-			stat := dto.UserStats(*u)
-			resp.User = append(resp.User, stat)
+		weeks, err := s.statsProvider.GetThroughput(ctx, filter)
+		if err != nil {
+			return err
+		}
+		for _, w := range weeks {
+			resp.Throughput = append(resp.Throughput, dto.WeeklyThroughput(*w))
 		}
-		resp.Pr = dto.PrStats(*prStats)
 
 		return nil
 	})
@@ -55,3 +141,14 @@ func (s *StatsService) GetStatistics(ctx context.Context, sort string) (*dto.Sta
 
 	return resp, nil
 }
+
+// RebuildRollups refills the daily rollups the stats are read from, for a
+// backfill or after fixing data by hand.
+func (s *StatsService) RebuildRollups(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "StatsService.RebuildRollups")
+	defer span.End()
+
+	return s.trm.Do(ctx, func(ctx context.Context) error {
+		return s.statsProvider.RebuildRollups(ctx)
+	})
+}
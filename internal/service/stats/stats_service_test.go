@@ -3,11 +3,14 @@ package stats_test
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
+	"time"
 
 	"railgorail/avito/internal/entity"
 	"railgorail/avito/internal/service/mocks"
 	"railgorail/avito/internal/service/stats"
+	"railgorail/avito/internal/transport/http/dto"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,9 +23,10 @@ func TestStatsService_GetStatistics_Success(t *testing.T) {
 	t.Cleanup(func() { mockTx.AssertExpectations(t) })
 	mockStatsRepo := mocks.NewStatsProvider(t)
 
-	sort := "desc"
+	filter := entity.StatsFilter{Sort: "desc", GroupBy: entity.StatsGroupByUser}
 	userStatistics := []*entity.UserStatistics{
-		{UserID: "dev-a", Username: "Alex", AssignmentCount: 25},
+		{UserID: "dev-a", Username: "Alex", AssignmentCount: 25, WeightedLoad: 40,
+			Assigned: 31, Reassigned: 6, Completed: 20, Open: 5},
 		{UserID: "dev-b", Username: "Boris", AssignmentCount: 20},
 		{UserID: "dev-c", Username: "Charles", AssignmentCount: 15},
 	}
@@ -32,8 +36,9 @@ func TestStatsService_GetStatistics_Success(t *testing.T) {
 		MergedPrs: 140,
 	}
 
-	mockStatsRepo.On("GetAssignmentsCountStats", ctx, sort).Return(userStatistics, nil).Once()
-	mockStatsRepo.On("GetPrStats", ctx).Return(prStatistics, nil).Once()
+	mockStatsRepo.On("GetAssignmentsCountStats", ctx, filter).Return(userStatistics, nil).Once()
+	mockStatsRepo.On("GetPrStats", ctx, filter).Return(prStatistics, nil).Once()
+	mockStatsRepo.On("GetTeamPrStats", ctx, filter).Return([]*entity.TeamPrStatistics{}, nil).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -44,7 +49,7 @@ func TestStatsService_GetStatistics_Success(t *testing.T) {
 		Once()
 
 	statsSvc := stats.NewStatsService(mockTx, mockStatsRepo)
-	result, e := statsSvc.GetStatistics(ctx, sort)
+	result, e := statsSvc.GetStatistics(ctx, filter)
 
 	assert.NoError(t, e)
 	assert.NotNil(t, result)
@@ -53,6 +58,11 @@ func TestStatsService_GetStatistics_Success(t *testing.T) {
 	assert.Equal(t, "dev-a", result.User[0].UserID)
 	assert.Equal(t, "Alex", result.User[0].Username)
 	assert.Equal(t, 25, result.User[0].AssignmentCount)
+	assert.Equal(t, 40, result.User[0].WeightedLoad)
+	assert.Equal(t, 31, result.User[0].Assigned)
+	assert.Equal(t, 6, result.User[0].Reassigned)
+	assert.Equal(t, 20, result.User[0].Completed)
+	assert.Equal(t, 5, result.User[0].Open)
 
 	assert.Equal(t, "dev-b", result.User[1].UserID)
 	assert.Equal(t, "Boris", result.User[1].Username)
@@ -74,7 +84,7 @@ func TestStatsService_GetStatistics_EmptyUserStats(t *testing.T) {
 	t.Cleanup(func() { mockTx.AssertExpectations(t) })
 	mockStatsRepo := mocks.NewStatsProvider(t)
 
-	sort := "asc"
+	filter := entity.StatsFilter{Sort: "asc", GroupBy: entity.StatsGroupByUser}
 	userStatistics := []*entity.UserStatistics{}
 	prStatistics := &entity.PrStatistics{
 		PrCount:   0,
@@ -82,8 +92,9 @@ func TestStatsService_GetStatistics_EmptyUserStats(t *testing.T) {
 		MergedPrs: 0,
 	}
 
-	mockStatsRepo.On("GetAssignmentsCountStats", ctx, sort).Return(userStatistics, nil).Once()
-	mockStatsRepo.On("GetPrStats", ctx).Return(prStatistics, nil).Once()
+	mockStatsRepo.On("GetAssignmentsCountStats", ctx, filter).Return(userStatistics, nil).Once()
+	mockStatsRepo.On("GetPrStats", ctx, filter).Return(prStatistics, nil).Once()
+	mockStatsRepo.On("GetTeamPrStats", ctx, filter).Return([]*entity.TeamPrStatistics{}, nil).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -94,7 +105,7 @@ func TestStatsService_GetStatistics_EmptyUserStats(t *testing.T) {
 		Once()
 
 	statsSvc := stats.NewStatsService(mockTx, mockStatsRepo)
-	result, e := statsSvc.GetStatistics(ctx, sort)
+	result, e := statsSvc.GetStatistics(ctx, filter)
 
 	assert.NoError(t, e)
 	assert.NotNil(t, result)
@@ -111,10 +122,10 @@ func TestStatsService_GetStatistics_GetAssignmentsCountStatsError(t *testing.T)
 	t.Cleanup(func() { mockTx.AssertExpectations(t) })
 	mockStatsRepo := mocks.NewStatsProvider(t)
 
-	sort := "desc"
+	filter := entity.StatsFilter{Sort: "desc", GroupBy: entity.StatsGroupByUser}
 	databaseError := errors.New("db connection lost")
 
-	mockStatsRepo.On("GetAssignmentsCountStats", ctx, sort).Return(([]*entity.UserStatistics)(nil), databaseError).Once()
+	mockStatsRepo.On("GetAssignmentsCountStats", ctx, filter).Return(([]*entity.UserStatistics)(nil), databaseError).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -127,7 +138,7 @@ func TestStatsService_GetStatistics_GetAssignmentsCountStatsError(t *testing.T)
 		Once()
 
 	statsSvc := stats.NewStatsService(mockTx, mockStatsRepo)
-	result, e := statsSvc.GetStatistics(ctx, sort)
+	result, e := statsSvc.GetStatistics(ctx, filter)
 
 	assert.Nil(t, result)
 	assert.Error(t, e)
@@ -141,14 +152,14 @@ func TestStatsService_GetStatistics_GetPrStatsError(t *testing.T) {
 	t.Cleanup(func() { mockTx.AssertExpectations(t) })
 	mockStatsRepo := mocks.NewStatsProvider(t)
 
-	sort := "asc"
+	filter := entity.StatsFilter{Sort: "asc", GroupBy: entity.StatsGroupByUser}
 	databaseError := errors.New("pr stats unavailable")
 	userStatistics := []*entity.UserStatistics{
 		{UserID: "dev-a", Username: "David", AssignmentCount: 9},
 	}
 
-	mockStatsRepo.On("GetAssignmentsCountStats", ctx, sort).Return(userStatistics, nil).Once()
-	mockStatsRepo.On("GetPrStats", ctx).Return((*entity.PrStatistics)(nil), databaseError).Once()
+	mockStatsRepo.On("GetAssignmentsCountStats", ctx, filter).Return(userStatistics, nil).Once()
+	mockStatsRepo.On("GetPrStats", ctx, filter).Return((*entity.PrStatistics)(nil), databaseError).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -161,9 +172,173 @@ func TestStatsService_GetStatistics_GetPrStatsError(t *testing.T) {
 		Once()
 
 	statsSvc := stats.NewStatsService(mockTx, mockStatsRepo)
-	result, e := statsSvc.GetStatistics(ctx, sort)
+	result, e := statsSvc.GetStatistics(ctx, filter)
 
 	assert.Nil(t, result)
 	assert.Error(t, e)
 	assert.ErrorIs(t, e, databaseError)
 }
+
+func TestStatsService_GetStatistics_GroupedByWeekForTeam(t *testing.T) {
+	ctx := context.Background()
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	mockStatsRepo := mocks.NewStatsProvider(t)
+
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+	filter := entity.StatsFilter{Sort: "desc", TeamName: "backend", From: &from, To: &to, GroupBy: entity.StatsGroupByWeek}
+
+	mockStatsRepo.On("GetGroupedAssignmentStats", ctx, filter).Return([]*entity.GroupStatistics{
+		{Key: "2025-03-03", AssignmentCount: 12, WeightedLoad: 20},
+		{Key: "2025-03-10", AssignmentCount: 7, WeightedLoad: 9},
+	}, nil).Once()
+	mockStatsRepo.On("GetPrStats", ctx, filter).Return(&entity.PrStatistics{PrCount: 10, OpenPrs: 4, MergedPrs: 6}, nil).Once()
+	mockStatsRepo.On("GetTeamPrStats", ctx, filter).Return([]*entity.TeamPrStatistics{
+		{TeamName: "backend", PrStatistics: entity.PrStatistics{PrCount: 10, OpenPrs: 4, MergedPrs: 6}},
+	}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).
+		Once()
+
+	statsSvc := stats.NewStatsService(mockTx, mockStatsRepo)
+	result, e := statsSvc.GetStatistics(ctx, filter)
+
+	assert.NoError(t, e)
+	assert.Empty(t, result.User)
+	assert.Equal(t, []dto.GroupStats{
+		{Key: "2025-03-03", AssignmentCount: 12, WeightedLoad: 20},
+		{Key: "2025-03-10", AssignmentCount: 7, WeightedLoad: 9},
+	}, result.Groups)
+	assert.Equal(t, "backend", result.Teams[0].TeamName)
+	assert.Equal(t, 4, result.Teams[0].OpenPrs)
+	mockStatsRepo.AssertNotCalled(t, "GetAssignmentsCountStats", mock.Anything, mock.Anything)
+}
+
+func TestStatsService_GetLatency_Success(t *testing.T) {
+	ctx := context.Background()
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	mockStatsRepo := mocks.NewStatsProvider(t)
+
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	filter := entity.StatsFilter{Sort: "desc", From: &from, GroupBy: entity.StatsGroupByUser}
+
+	mockStatsRepo.On("GetMergeLatency", ctx, filter).Return([]*entity.TeamLatency{
+		{TeamName: "backend", Percentiles: entity.Percentiles{Count: 4, P50: 3600, P90: 7200, P99: 9000}},
+	}, nil).Once()
+	mockStatsRepo.On("GetReviewLatency", ctx, filter).Return([]*entity.ReviewerLatency{
+		{UserID: "dev-a", Username: "Alex", Percentiles: entity.Percentiles{Count: 2, P50: 1800, P90: 2400, P99: 2500}},
+	}, nil).Once()
+	mockStatsRepo.On("GetThroughput", ctx, filter).Return([]*entity.WeeklyThroughput{
+		{Week: "2025-03-03", Merged: 3},
+		{Week: "2025-03-10", Merged: 1},
+	}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).
+		Once()
+
+	statsSvc := stats.NewStatsService(mockTx, mockStatsRepo)
+	result, e := statsSvc.GetLatency(ctx, filter)
+
+	assert.NoError(t, e)
+	assert.Equal(t, []dto.TeamLatency{
+		{TeamName: "backend", Percentiles: dto.Percentiles{Count: 4, P50: 3600, P90: 7200, P99: 9000}},
+	}, result.TimeToMerge)
+	assert.Equal(t, "dev-a", result.TimeInReview[0].UserID)
+	assert.Equal(t, 1800.0, result.TimeInReview[0].P50)
+	assert.Equal(t, []dto.WeeklyThroughput{
+		{Week: "2025-03-03", Merged: 3},
+		{Week: "2025-03-10", Merged: 1},
+	}, result.Throughput)
+}
+
+func TestStatsService_GetFairness(t *testing.T) {
+	ctx := context.Background()
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	mockStatsRepo := mocks.NewStatsProvider(t)
+
+	filter := entity.StatsFilter{Sort: "desc", GroupBy: entity.StatsGroupByUser}
+
+	mockStatsRepo.On("GetMemberLoad", ctx, filter).Return([]*entity.MemberLoad{
+		// dev-b was away half the window, the same rate as dev-a
+		{TeamName: "backend", UserID: "dev-a", Username: "Alex", AssignmentCount: 10, ActiveDays: 10},
+		{TeamName: "backend", UserID: "dev-b", Username: "Boris", AssignmentCount: 5, ActiveDays: 5},
+		{TeamName: "backend", UserID: "dev-c", Username: "Charles", AssignmentCount: 30, ActiveDays: 10},
+		{TeamName: "backend", UserID: "dev-d", Username: "Dan", AssignmentCount: 0, ActiveDays: 0},
+		{TeamName: "frontend", UserID: "dev-e", Username: "Eva", AssignmentCount: 4, ActiveDays: 10},
+		{TeamName: "frontend", UserID: "dev-f", Username: "Fedor", AssignmentCount: 4, ActiveDays: 10},
+	}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).
+		Once()
+
+	statsSvc := stats.NewStatsService(mockTx, mockStatsRepo)
+	result, e := statsSvc.GetFairness(ctx, filter)
+
+	assert.NoError(t, e)
+	assert.Len(t, result.Teams, 2)
+
+	backend := result.Teams[0]
+	assert.Equal(t, "backend", backend.TeamName)
+	assert.Equal(t, 3, backend.Members)
+	assert.Equal(t, 45, backend.AssignmentCount)
+	assert.InDelta(t, 5.0/3, backend.MeanRate, 1e-9)
+	assert.InDelta(t, 4.0/15, backend.Gini, 1e-9)
+	assert.InDelta(t, 3.0, *backend.MaxMinRatio, 1e-9)
+	assert.InDelta(t, math.Sqrt(8.0/9), backend.StdDev, 1e-9)
+	assert.Len(t, backend.Outliers, 1)
+	assert.Equal(t, "dev-c", backend.Outliers[0].UserID)
+	assert.Equal(t, stats.LoadOver, backend.Outliers[0].Load)
+	assert.InDelta(t, 50.0/3, backend.Outliers[0].Expected, 1e-9)
+
+	frontend := result.Teams[1]
+	assert.Equal(t, "frontend", frontend.TeamName)
+	assert.InDelta(t, 0.0, frontend.Gini, 1e-9)
+	assert.Zero(t, frontend.StdDev)
+	assert.InDelta(t, 1.0, *frontend.MaxMinRatio, 1e-9)
+	assert.Empty(t, frontend.Outliers)
+}
+
+func TestStatsService_RebuildRollups(t *testing.T) {
+	ctx := context.Background()
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	mockStatsRepo := mocks.NewStatsProvider(t)
+
+	repoErr := errors.New("lock timeout")
+	mockStatsRepo.On("RebuildRollups", ctx).Return(repoErr).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.ErrorIs(t, fn(ctx), repoErr)
+		}).
+		Return(repoErr).
+		Once()
+
+	statsSvc := stats.NewStatsService(mockTx, mockStatsRepo)
+	err := statsSvc.RebuildRollups(ctx)
+
+	assert.ErrorIs(t, err, repoErr)
+}
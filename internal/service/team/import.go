@@ -0,0 +1,156 @@
+package team
+
+import (
+	"context"
+	"errors"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/metrics"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/tracing"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+// Import adds the rows on top of what is already stored: missing teams and
+// users are created, users are updated and joined to the listed teams. Nothing
+// is removed. Either every row is applied or none.
+func (s *TeamService) Import(ctx context.Context, rows []dto.TeamRow) (*dto.ImportResponse, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.Import")
+	defer span.End()
+
+	resp := &dto.ImportResponse{
+		Rows:              len(rows),
+		TeamsCreated:      []string{},
+		ReassignedReviews: []dto.ReassignedReview{},
+	}
+	// teams with new active members, they may take pending seats
+	gained := make(map[int]struct{})
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		teamIDs := make(map[string]int)
+		seenUsers := make(map[string]struct{})
+		var toRelease, reactivated []string
+
+		for _, row := range rows {
+			teamID, ok := teamIDs[row.TeamName]
+			if !ok {
+				team, err := s.teamProvider.GetByTeamName(ctx, row.TeamName)
+				switch {
+				case err == nil:
+					teamID = team.ID
+				case errors.Is(err, repo.ErrNotFound):
+					teamID, err = s.teamProvider.Create(ctx, row.TeamName)
+					if err != nil {
+						return err
+					}
+					resp.TeamsCreated = append(resp.TeamsCreated, row.TeamName)
+				default:
+					return err
+				}
+				teamIDs[row.TeamName] = teamID
+			}
+
+			if _, ok := seenUsers[row.UserID]; !ok {
+				seenUsers[row.UserID] = struct{}{}
+
+				current, err := s.userProvider.GetById(ctx, row.UserID)
+				switch {
+				case errors.Is(err, repo.ErrNotFound):
+					resp.UsersCreated++
+				case err != nil:
+					return err
+				case current.Name != row.Username || current.IsActive != row.IsActive:
+					resp.UsersUpdated++
+					if current.IsActive && !row.IsActive {
+						toRelease = append(toRelease, row.UserID)
+					}
+					if !current.IsActive && row.IsActive {
+						reactivated = append(reactivated, row.UserID)
+					}
+				}
+
+				user := &entity.User{ID: row.UserID, Name: row.Username, IsActive: row.IsActive}
+				if _, err := s.userProvider.Save(ctx, user); err != nil {
+					return err
+				}
+			}
+
+			if err := s.userProvider.AddToTeam(ctx, row.UserID, teamID); err != nil {
+				return err
+			}
+			if row.IsActive {
+				gained[teamID] = struct{}{}
+			}
+		}
+		if err := s.addTeamsOf(ctx, reactivated, gained); err != nil {
+			return err
+		}
+
+		for _, id := range toRelease {
+			released, err := s.releaseReviews(ctx, id, nil, entity.UnassignDeactivated)
+			if err != nil {
+				return err
+			}
+			resp.ReassignedReviews = append(resp.ReassignedReviews, released...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range resp.ReassignedReviews {
+		metrics.Released(r.Reason, r.ReplacedBy)
+	}
+	s.wake(gained)
+
+	return resp, nil
+}
+
+// Export returns one row per team membership, limited to a single team when
+// teamName is set.
+func (s *TeamService) Export(ctx context.Context, teamName string) ([]dto.TeamRow, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.Export")
+	defer span.End()
+
+	if teamName != "" {
+		if _, err := s.teamProvider.GetByTeamName(ctx, teamName); err != nil {
+			return nil, service.With(err, "team_name", teamName)
+		}
+	}
+
+	memberships, err := s.teamProvider.GetMemberships(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.userProvider.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*entity.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	rows := make([]dto.TeamRow, 0, len(memberships))
+	for _, m := range memberships {
+		if teamName != "" && m.TeamName != teamName {
+			continue
+		}
+		u, ok := byID[m.UserID]
+		if !ok {
+			continue
+		}
+		rows = append(rows, dto.TeamRow{
+			TeamName: m.TeamName,
+			UserID:   u.ID,
+			Username: u.Name,
+			IsActive: u.IsActive,
+		})
+	}
+
+	return rows, nil
+}
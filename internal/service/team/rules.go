@@ -0,0 +1,185 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/tracing"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+// maxRuleReviewers matches the upper bound of a size tier.
+const maxRuleReviewers = 5
+
+func (s *TeamService) GetRoutingRules(ctx context.Context, teamName string) (*dto.RoutingRulesResponse, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.GetRoutingRules")
+	defer span.End()
+
+	team, err := s.teamProvider.GetByTeamName(ctx, teamName)
+	if err != nil {
+		return nil, service.With(err, "team_name", teamName)
+	}
+
+	rules, err := s.teamProvider.GetRoutingRules(ctx, team.ID)
+	if err != nil {
+		return nil, service.With(err, "team_name", teamName)
+	}
+
+	resp := &dto.RoutingRulesResponse{
+		TeamName: teamName,
+		Rules:    make([]dto.RoutingRule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, toRoutingRuleSchema(r))
+	}
+	return resp, nil
+}
+
+func (s *TeamService) AddRoutingRule(ctx context.Context, teamName string, rule dto.RoutingRule) (*dto.RoutingRule, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.AddRoutingRule")
+	defer span.End()
+
+	var resp dto.RoutingRule
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamProvider.GetByTeamName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		e, err := s.toRoutingRule(ctx, team.ID, rule)
+		if err != nil {
+			return err
+		}
+
+		ruleID, err := s.teamProvider.CreateRoutingRule(ctx, e)
+		if err != nil {
+			return err
+		}
+
+		saved, err := s.teamProvider.GetRoutingRule(ctx, ruleID)
+		if err != nil {
+			return err
+		}
+
+		resp = toRoutingRuleSchema(saved)
+		return nil
+	})
+	if err != nil {
+		return nil, service.With(err, "team_name", teamName)
+	}
+
+	return &resp, nil
+}
+
+// UpdateRoutingRule rewrites the rule with id rule.ID, the rule stays with its
+// team.
+func (s *TeamService) UpdateRoutingRule(ctx context.Context, rule dto.RoutingRule) (*dto.RoutingRule, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.UpdateRoutingRule")
+	defer span.End()
+
+	var resp dto.RoutingRule
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		current, err := s.teamProvider.GetRoutingRule(ctx, rule.ID)
+		if err != nil {
+			return err
+		}
+
+		e, err := s.toRoutingRule(ctx, current.TeamID, rule)
+		if err != nil {
+			return err
+		}
+		e.ID = current.ID
+
+		if err := s.teamProvider.UpdateRoutingRule(ctx, e); err != nil {
+			return err
+		}
+
+		saved, err := s.teamProvider.GetRoutingRule(ctx, rule.ID)
+		if err != nil {
+			return err
+		}
+
+		resp = toRoutingRuleSchema(saved)
+		return nil
+	})
+	if err != nil {
+		return nil, service.With(err, "rule_id", rule.ID)
+	}
+
+	return &resp, nil
+}
+
+func (s *TeamService) DeleteRoutingRule(ctx context.Context, ruleID int) error {
+	ctx, span := tracing.Start(ctx, "TeamService.DeleteRoutingRule")
+	defer span.End()
+
+	return service.With(s.teamProvider.DeleteRoutingRule(ctx, ruleID), "rule_id", ruleID)
+}
+
+// toRoutingRule validates rule and resolves its target team.
+func (s *TeamService) toRoutingRule(ctx context.Context, teamID int, rule dto.RoutingRule) (*entity.RoutingRule, error) {
+	e := &entity.RoutingRule{
+		TeamID: teamID,
+		Label:  strings.TrimSpace(rule.Label),
+		Action: rule.Action,
+	}
+	if e.Label == "" {
+		return nil, service.Fail(repo.ErrInvalidRule, "label is empty")
+	}
+
+	switch rule.Action {
+	case entity.RuleActionAddTeamMember:
+		if rule.Reviewers != nil {
+			return nil, service.Fail(repo.ErrInvalidRule, fmt.Sprintf("%s does not take reviewers", rule.Action), "action", rule.Action)
+		}
+		if rule.TargetTeamName == "" {
+			return nil, service.Fail(repo.ErrInvalidRule, fmt.Sprintf("%s needs target_team_name", rule.Action), "action", rule.Action)
+		}
+
+		target, err := s.teamProvider.GetByTeamName(ctx, rule.TargetTeamName)
+		if err != nil {
+			if errors.Is(err, repo.ErrNotFound) {
+				return nil, service.Fail(repo.ErrInvalidRule, fmt.Sprintf("team %s not found", rule.TargetTeamName), "target_team_name", rule.TargetTeamName)
+			}
+			return nil, err
+		}
+		if target.ID == teamID {
+			return nil, service.Fail(repo.ErrInvalidRule, "target team is the rule's own team", "target_team_name", rule.TargetTeamName)
+		}
+		e.TargetTeamID = &target.ID
+
+	case entity.RuleActionSetReviewers:
+		if rule.TargetTeamName != "" {
+			return nil, service.Fail(repo.ErrInvalidRule, fmt.Sprintf("%s does not take target_team_name", rule.Action), "action", rule.Action)
+		}
+		if rule.Reviewers == nil || *rule.Reviewers < 1 || *rule.Reviewers > maxRuleReviewers {
+			return nil, service.Fail(repo.ErrInvalidRule, fmt.Sprintf("%s needs reviewers from 1 to %d", rule.Action, maxRuleReviewers), "action", rule.Action)
+		}
+		e.Reviewers = rule.Reviewers
+
+	default:
+		return nil, service.Fail(repo.ErrInvalidRule, fmt.Sprintf("unknown action %q", rule.Action), "action", rule.Action)
+	}
+
+	return e, nil
+}
+
+func toRoutingRuleSchema(r *entity.RoutingRule) dto.RoutingRule {
+	rule := dto.RoutingRule{
+		ID:        r.ID,
+		Label:     r.Label,
+		Action:    r.Action,
+		Reviewers: r.Reviewers,
+	}
+	if r.TargetTeamName != nil {
+		rule.TargetTeamName = *r.TargetTeamName
+	}
+	return rule
+}
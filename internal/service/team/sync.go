@@ -0,0 +1,380 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib"
+	"railgorail/avito/internal/metrics"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/tracing"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+const (
+	ActionCreateTeam     = "create_team"
+	ActionCreateUser     = "create_user"
+	ActionUpdateUser     = "update_user"
+	ActionJoinTeam       = "join_team"
+	ActionLeaveTeam      = "leave_team"
+	ActionDeactivateUser = "deactivate_user"
+
+	statusOpen = "OPEN"
+
+	// DefaultMaxDeactivations caps a sync that does not set its own limit,
+	// a truncated roster would otherwise deactivate everybody.
+	DefaultMaxDeactivations = 10
+)
+
+// SyncOptions narrows what Sync is allowed to change. The zero value treats the
+// roster as the complete picture of the organisation.
+type SyncOptions struct {
+	DryRun bool
+	// OnlyListedTeams keeps memberships in teams the roster does not list.
+	OnlyListedTeams bool
+	// KeepUsers are not deactivated even when the roster does not mention them.
+	KeepUsers []string
+	// KeepIsActive applies is_active from the roster to new users only.
+	KeepIsActive bool
+	// MaxDeactivations fails the sync when more users would become inactive,
+	// 0 means no limit.
+	MaxDeactivations int
+}
+
+type membership struct {
+	userID   string
+	teamName string
+}
+
+// Sync brings teams and users in line with the roster. The roster is the full
+// truth for the users it mentions: they are created or updated, and moved
+// between teams. Users missing from the roster are deactivated but keep their
+// memberships, like /users/setIsActive does. Teams are never deleted.
+//
+// With opts.DryRun the planned changes are returned and nothing is written.
+func (s *TeamService) Sync(ctx context.Context, roster *dto.Roster, opts SyncOptions) (*dto.SyncResponse, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.Sync")
+	defer span.End()
+
+	desiredUsers, desiredMembers, err := flattenRoster(roster)
+	if err != nil {
+		return nil, err
+	}
+	desired := make(map[membership]struct{}, len(desiredMembers))
+	for _, m := range desiredMembers {
+		desired[m] = struct{}{}
+	}
+
+	listedTeams := make(map[string]struct{}, len(roster.Teams))
+	for _, t := range roster.Teams {
+		listedTeams[t.TeamName] = struct{}{}
+	}
+
+	keepUsers := make(map[string]struct{}, len(opts.KeepUsers))
+	for _, id := range opts.KeepUsers {
+		keepUsers[id] = struct{}{}
+	}
+
+	resp := &dto.SyncResponse{
+		DryRun:            opts.DryRun,
+		Changes:           []dto.SyncChange{},
+		ReassignedReviews: []dto.ReassignedReview{},
+	}
+	// teams with new active members, they may take pending seats
+	gained := make(map[int]struct{})
+
+	err = s.trm.Do(ctx, func(ctx context.Context) error {
+		teams, err := s.teamProvider.List(ctx)
+		if err != nil {
+			return err
+		}
+		teamIDs := make(map[string]int, len(teams))
+		for _, t := range teams {
+			teamIDs[t.Name] = t.ID
+		}
+
+		users, err := s.userProvider.GetAll(ctx)
+		if err != nil {
+			return err
+		}
+		currentUsers := make(map[string]*entity.User, len(users))
+		for _, u := range users {
+			currentUsers[u.ID] = u
+		}
+
+		memberships, err := s.teamProvider.GetMemberships(ctx)
+		if err != nil {
+			return err
+		}
+		currentMembers := make(map[membership]int, len(memberships))
+		for _, m := range memberships {
+			currentMembers[membership{userID: m.UserID, teamName: m.TeamName}] = m.TeamID
+		}
+
+		var (
+			toSave       []*entity.User
+			toJoin       []membership
+			toLeave      []membership
+			toDeactivate []string
+			toRelease    []string
+			leftTeamIDs  = make(map[string][]int)
+		)
+
+		for _, t := range roster.Teams {
+			if _, ok := teamIDs[t.TeamName]; !ok {
+				resp.Changes = append(resp.Changes, dto.SyncChange{Action: ActionCreateTeam, TeamName: t.TeamName})
+			}
+		}
+
+		for _, id := range sortedKeys(desiredUsers) {
+			want := desiredUsers[id]
+			have, ok := currentUsers[id]
+			if ok && opts.KeepIsActive {
+				want.IsActive = have.IsActive
+			}
+			switch {
+			case !ok:
+				resp.Changes = append(resp.Changes, dto.SyncChange{Action: ActionCreateUser, UserID: id})
+			case have.Name != want.Name || have.IsActive != want.IsActive:
+				resp.Changes = append(resp.Changes, dto.SyncChange{Action: ActionUpdateUser, UserID: id})
+				if have.IsActive && !want.IsActive {
+					toRelease = append(toRelease, id)
+				}
+			default:
+				continue
+			}
+			toSave = append(toSave, want)
+		}
+
+		for _, m := range desiredMembers {
+			if _, ok := currentMembers[m]; !ok {
+				resp.Changes = append(resp.Changes, dto.SyncChange{Action: ActionJoinTeam, UserID: m.userID, TeamName: m.teamName})
+				toJoin = append(toJoin, m)
+			}
+		}
+
+		for _, m := range memberships {
+			key := membership{userID: m.UserID, teamName: m.TeamName}
+			if _, ok := desiredUsers[m.UserID]; !ok {
+				continue
+			}
+			if _, ok := desired[key]; ok {
+				continue
+			}
+			if _, ok := listedTeams[m.TeamName]; !ok && opts.OnlyListedTeams {
+				continue
+			}
+			resp.Changes = append(resp.Changes, dto.SyncChange{Action: ActionLeaveTeam, UserID: m.UserID, TeamName: m.TeamName})
+			toLeave = append(toLeave, key)
+			leftTeamIDs[m.UserID] = append(leftTeamIDs[m.UserID], m.TeamID)
+		}
+
+		for _, u := range users {
+			if _, ok := desiredUsers[u.ID]; ok || !u.IsActive {
+				continue
+			}
+			if _, ok := keepUsers[u.ID]; ok {
+				continue
+			}
+			resp.Changes = append(resp.Changes, dto.SyncChange{Action: ActionDeactivateUser, UserID: u.ID})
+			toDeactivate = append(toDeactivate, u.ID)
+			toRelease = append(toRelease, u.ID)
+		}
+
+		// a dry run reports the limit too, it is how a roster gets checked
+		if opts.MaxDeactivations > 0 && len(toRelease) > opts.MaxDeactivations {
+			return service.Fail(repo.ErrTooManyDeactivations,
+				fmt.Sprintf("%d users, at most %d allowed", len(toRelease), opts.MaxDeactivations),
+				"deactivations", len(toRelease), "max_deactivations", opts.MaxDeactivations)
+		}
+
+		if opts.DryRun {
+			return nil
+		}
+
+		for _, t := range roster.Teams {
+			if _, ok := teamIDs[t.TeamName]; ok {
+				continue
+			}
+			teamID, err := s.teamProvider.Create(ctx, t.TeamName)
+			if err != nil {
+				return err
+			}
+			teamIDs[t.TeamName] = teamID
+		}
+
+		for _, u := range toSave {
+			if _, err := s.userProvider.Save(ctx, u); err != nil {
+				return err
+			}
+		}
+
+		for _, m := range toJoin {
+			if err := s.userProvider.AddToTeam(ctx, m.userID, teamIDs[m.teamName]); err != nil {
+				return err
+			}
+			if desiredUsers[m.userID].IsActive {
+				gained[teamIDs[m.teamName]] = struct{}{}
+			}
+		}
+
+		for _, u := range toSave {
+			if have, ok := currentUsers[u.ID]; !ok || have.IsActive || !u.IsActive {
+				continue
+			}
+			for _, m := range memberships {
+				if m.UserID == u.ID {
+					gained[m.TeamID] = struct{}{}
+				}
+			}
+		}
+
+		for _, m := range toLeave {
+			if err := s.userProvider.RemoveFromTeam(ctx, m.userID, currentMembers[m]); err != nil {
+				return err
+			}
+		}
+
+		for _, id := range toDeactivate {
+			if err := s.userProvider.SetIsActive(ctx, id, false); err != nil {
+				return err
+			}
+		}
+
+		// reviews follow the people: inactive users give up all open reviews,
+		// users who left a team give up open reviews of that team's PRs
+		for _, id := range toRelease {
+			released, err := s.releaseReviews(ctx, id, nil, entity.UnassignDeactivated)
+			if err != nil {
+				return err
+			}
+			resp.ReassignedReviews = append(resp.ReassignedReviews, released...)
+			delete(leftTeamIDs, id)
+		}
+
+		for _, id := range sortedKeys(leftTeamIDs) {
+			released, err := s.releaseReviews(ctx, id, leftTeamIDs[id], entity.UnassignLeftTeam)
+			if err != nil {
+				return err
+			}
+			resp.ReassignedReviews = append(resp.ReassignedReviews, released...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range resp.ReassignedReviews {
+		metrics.Released(r.Reason, r.ReplacedBy)
+	}
+	s.wake(gained)
+
+	return resp, nil
+}
+
+// releaseReviews hands the user's OPEN reviews over to other active members of
+// the PR's team. When teamIDs is not empty only PRs of those teams are touched.
+// reason is recorded in the assignment history.
+func (s *TeamService) releaseReviews(ctx context.Context, userID string, teamIDs []int, reason string) ([]dto.ReassignedReview, error) {
+	prs, err := s.prProvider.GetUserReviews(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var released []dto.ReassignedReview
+	for _, pr := range prs {
+		if pr.Status != statusOpen {
+			continue
+		}
+		if len(teamIDs) > 0 && !slices.Contains(teamIDs, pr.TeamID) {
+			continue
+		}
+
+		activeUsers, err := s.userProvider.GetActiveUsersIDInTeam(ctx, pr.TeamID)
+		if err != nil {
+			return nil, err
+		}
+
+		assignedReviewers, err := s.prProvider.GetPrReviewers(ctx, pr.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		review := dto.ReassignedReview{PullRequestID: pr.ID, OldReviewerID: userID, Reason: reason}
+
+		excluded := append([]string{pr.AuthorId, userID}, assignedReviewers...)
+		candidates := lib.RandomUsers(activeUsers, 1, excluded...)
+		if len(candidates) == 0 {
+			err = s.prProvider.DeleteReviewer(ctx, pr.ID, userID, reason)
+		} else {
+			review.ReplacedBy = candidates[0]
+			err = s.prProvider.ReassignReviewer(ctx, pr.ID, userID, candidates[0], reason)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		released = append(released, review)
+	}
+
+	return released, nil
+}
+
+func flattenRoster(roster *dto.Roster) (map[string]*entity.User, []membership, error) {
+	users := make(map[string]*entity.User)
+	members := make([]membership, 0)
+	seenTeams := make(map[string]struct{}, len(roster.Teams))
+	seenMembers := make(map[membership]struct{})
+
+	// an empty roster would deactivate every user
+	if len(roster.Teams) == 0 {
+		return nil, nil, service.Fail(repo.ErrInvalidRoster, "roster has no teams")
+	}
+
+	for _, t := range roster.Teams {
+		if t.TeamName == "" {
+			return nil, nil, service.Fail(repo.ErrInvalidRoster, "team_name is required")
+		}
+		if _, ok := seenTeams[t.TeamName]; ok {
+			return nil, nil, service.Fail(repo.ErrInvalidRoster, fmt.Sprintf("team %q is listed twice", t.TeamName), "team_name", t.TeamName)
+		}
+		seenTeams[t.TeamName] = struct{}{}
+
+		for _, m := range t.Members {
+			if m.UserID == "" || m.Username == "" {
+				return nil, nil, service.Fail(repo.ErrInvalidRoster, fmt.Sprintf("team %q has a member without user_id or username", t.TeamName), "team_name", t.TeamName)
+			}
+
+			if u, ok := users[m.UserID]; ok {
+				if u.Name != m.Username || u.IsActive != m.IsActive {
+					return nil, nil, service.Fail(repo.ErrInvalidRoster, fmt.Sprintf("user %q is described differently in several teams", m.UserID), "user_id", m.UserID)
+				}
+			} else {
+				users[m.UserID] = &entity.User{ID: m.UserID, Name: m.Username, IsActive: m.IsActive}
+			}
+
+			key := membership{userID: m.UserID, teamName: t.TeamName}
+			if _, ok := seenMembers[key]; ok {
+				return nil, nil, service.Fail(repo.ErrInvalidRoster, fmt.Sprintf("user %q is listed twice in team %q", m.UserID, t.TeamName), "user_id", m.UserID, "team_name", t.TeamName)
+			}
+			seenMembers[key] = struct{}{}
+			members = append(members, key)
+		}
+	}
+
+	return users, members, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
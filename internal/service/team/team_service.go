@@ -2,20 +2,37 @@ package team
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/repo"
 	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/tracing"
 	"railgorail/avito/internal/transport/http/dto"
 )
 
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=TeamProvider
 type TeamProvider interface {
 	Create(ctx context.Context, teamName string) (int, error)
 	GetByTeamName(ctx context.Context, teamName string) (*entity.Team, error)
+	List(ctx context.Context) ([]*entity.Team, error)
+	GetMemberships(ctx context.Context) ([]*entity.TeamMembership, error)
+	GetSizeTiers(ctx context.Context, teamID int) ([]*entity.SizeTier, error)
+	ReplaceSizeTiers(ctx context.Context, teamID int, tiers []*entity.SizeTier) error
+	GetRoutingRules(ctx context.Context, teamID int) ([]*entity.RoutingRule, error)
+	GetRoutingRule(ctx context.Context, ruleID int) (*entity.RoutingRule, error)
+	CreateRoutingRule(ctx context.Context, rule *entity.RoutingRule) (int, error)
+	UpdateRoutingRule(ctx context.Context, rule *entity.RoutingRule) error
+	DeleteRoutingRule(ctx context.Context, ruleID int) error
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=UserProvider
 type UserProvider interface {
 	Save(ctx context.Context, user *entity.User) (string, error)
+	AddToTeam(ctx context.Context, userID string, teamID int) error
+	RemoveFromTeam(ctx context.Context, userID string, teamID int) error
+	GetAll(ctx context.Context) ([]*entity.User, error)
 	GetUsersInTeam(ctx context.Context, teamName string) ([]*entity.User, error)
 	GetById(ctx context.Context, userID string) (*entity.User, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) error
@@ -26,8 +43,13 @@ type UserProvider interface {
 type PrProvider interface {
 	GetUserReviews(ctx context.Context, userID string) ([]*entity.PullRequest, error)
 	GetPrReviewers(ctx context.Context, prID string) ([]string, error)
-	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) error
-	DeleteReviewer(ctx context.Context, prID, userID string) error
+	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID, reason string) error
+	DeleteReviewer(ctx context.Context, prID, userID, reason string) error
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=SeatFiller
+type SeatFiller interface {
+	Wake(teamIDs ...int)
 }
 
 type TeamService struct {
@@ -35,25 +57,64 @@ type TeamService struct {
 	userProvider UserProvider
 	prProvider   PrProvider
 	trm          service.TransactionManager
+	seats        SeatFiller
 }
 
+// NewTeamService takes seats to fill pending reviewer seats of teams that
+// got new members, nil leaves them to the reconciler's poll.
 func NewTeamService(
 	trm service.TransactionManager,
 	teamProvider TeamProvider,
 	userProvider UserProvider,
 	prProvider PrProvider,
+	seats SeatFiller,
 ) *TeamService {
 	return &TeamService{
 		teamProvider: teamProvider,
 		userProvider: userProvider,
 		prProvider:   prProvider,
 		trm:          trm,
+		seats:        seats,
 	}
 }
 
+func (s *TeamService) wake(teamIDs map[int]struct{}) {
+	if s.seats == nil || len(teamIDs) == 0 {
+		return
+	}
+	ids := make([]int, 0, len(teamIDs))
+	for id := range teamIDs {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	s.seats.Wake(ids...)
+}
+
+// addTeamsOf adds the teams userIDs are members of to teamIDs.
+func (s *TeamService) addTeamsOf(ctx context.Context, userIDs []string, teamIDs map[int]struct{}) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	memberships, err := s.teamProvider.GetMemberships(ctx)
+	if err != nil {
+		return err
+	}
+	for _, m := range memberships {
+		if slices.Contains(userIDs, m.UserID) {
+			teamIDs[m.TeamID] = struct{}{}
+		}
+	}
+	return nil
+}
+
 func (s *TeamService) Add(ctx context.Context, teamName string, users []dto.TeamMember) (*dto.TeamSchema, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.Add")
+	defer span.End()
+
 	resp := &dto.TeamSchema{}
 	members := make([]dto.TeamMember, 0, len(users))
+	// teams of reactivated members, they may take pending seats
+	gained := make(map[int]struct{})
 
 	err := s.trm.Do(ctx, func(ctx context.Context) error {
 		teamID, err := s.teamProvider.Create(ctx, teamName)
@@ -61,15 +122,29 @@ func (s *TeamService) Add(ctx context.Context, teamName string, users []dto.Team
 			return err
 		}
 
+		var reactivated []string
 		for _, u := range users {
 			user := &entity.User{
 				ID:       u.UserID,
 				Name:     u.Username,
-				TeamID:   teamID,
 				IsActive: u.IsActive,
 			}
 
-			_, err := s.userProvider.Save(ctx, user)
+			current, err := s.userProvider.GetById(ctx, user.ID)
+			switch {
+			case errors.Is(err, repo.ErrNotFound):
+			case err != nil:
+				return err
+			case !current.IsActive && user.IsActive:
+				reactivated = append(reactivated, user.ID)
+			}
+
+			_, err = s.userProvider.Save(ctx, user)
+			if err != nil {
+				return err
+			}
+
+			err = s.userProvider.AddToTeam(ctx, user.ID, teamID)
 			if err != nil {
 				return err
 			}
@@ -86,26 +161,31 @@ func (s *TeamService) Add(ctx context.Context, teamName string, users []dto.Team
 		resp.TeamName = teamName
 		resp.Members = members
 
-		return nil
+		return s.addTeamsOf(ctx, reactivated, gained)
 	})
 	if err != nil {
-		return nil, err
+		return nil, service.With(err, "team_name", teamName)
 	}
 
+	s.wake(gained)
+
 	return resp, nil
 }
 
 func (s *TeamService) Get(ctx context.Context, teamName string) (*dto.TeamSchema, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.Get")
+	defer span.End()
+
 	resp := &dto.TeamSchema{}
 
 	_, err := s.teamProvider.GetByTeamName(ctx, teamName)
 	if err != nil {
-		return nil, err
+		return nil, service.With(err, "team_name", teamName)
 	}
 
 	users, err := s.userProvider.GetUsersInTeam(ctx, teamName)
 	if err != nil {
-		return nil, err
+		return nil, service.With(err, "team_name", teamName)
 	}
 
 	members := make([]dto.TeamMember, 0, len(users))
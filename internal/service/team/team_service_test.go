@@ -3,6 +3,7 @@ package team_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"railgorail/avito/internal/entity"
@@ -31,12 +32,16 @@ func TestTeamService_Add_Success(t *testing.T) {
 	teamID := 123
 
 	mockTeamRepo.On("Create", ctx, teamName).Return(teamID, nil)
+	mockUserRepo.On("GetById", ctx, "usr-a-1").Return((*entity.User)(nil), repo.ErrNotFound).Once()
+	mockUserRepo.On("GetById", ctx, "usr-b-2").Return(&entity.User{ID: "usr-b-2", Name: "Stepan"}, nil).Once()
 	mockUserRepo.On("Save", ctx, mock.MatchedBy(func(u *entity.User) bool {
-		return u.ID == "usr-a-1" && u.Name == "Anton" && u.TeamID == teamID && u.IsActive
+		return u.ID == "usr-a-1" && u.Name == "Anton" && u.IsActive
 	})).Return("", nil)
 	mockUserRepo.On("Save", ctx, mock.MatchedBy(func(u *entity.User) bool {
-		return u.ID == "usr-b-2" && u.Name == "Stepan" && u.TeamID == teamID && !u.IsActive
+		return u.ID == "usr-b-2" && u.Name == "Stepan" && !u.IsActive
 	})).Return("", nil)
+	mockUserRepo.On("AddToTeam", ctx, "usr-a-1", teamID).Return(nil).Once()
+	mockUserRepo.On("AddToTeam", ctx, "usr-b-2", teamID).Return(nil).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -45,7 +50,7 @@ func TestTeamService_Add_Success(t *testing.T) {
 		}).
 		Return(nil).Once()
 
-	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil)
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil, nil)
 	result, e := teamSvc.Add(ctx, teamName, users)
 
 	assert.NoError(t, e)
@@ -76,13 +81,57 @@ func TestTeamService_Add_TeamExists(t *testing.T) {
 		Return(repo.ErrTeamExists).
 		Once()
 
-	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, nil, nil)
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, nil, nil, nil)
 	result, e := teamSvc.Add(ctx, teamName, users)
 
 	assert.Nil(t, result)
 	assert.ErrorIs(t, e, repo.ErrTeamExists)
 }
 
+func TestTeamService_Add_WakesTeamsOfReactivatedMembers(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockUserRepo := mocks.NewUserProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	users := []dto.TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u2", Username: "Bob", IsActive: true},
+	}
+
+	mockTeamRepo.On("Create", ctx, "platform").Return(9, nil).Once()
+	// u1 comes back from a vacation, u2 was active all along
+	mockUserRepo.On("GetById", ctx, "u1").Return(&entity.User{ID: "u1", Name: "Alice", IsActive: false}, nil).Once()
+	mockUserRepo.On("GetById", ctx, "u2").Return(&entity.User{ID: "u2", Name: "Bob", IsActive: true}, nil).Once()
+	mockUserRepo.On("Save", ctx, mock.AnythingOfType("*entity.User")).Return("", nil).Twice()
+	mockUserRepo.On("AddToTeam", ctx, "u1", 9).Return(nil).Once()
+	mockUserRepo.On("AddToTeam", ctx, "u2", 9).Return(nil).Once()
+	mockTeamRepo.On("GetMemberships", ctx).Return([]*entity.TeamMembership{
+		{TeamID: 1, UserID: "u1"},
+		{TeamID: 2, UserID: "u2"},
+		{TeamID: 9, UserID: "u1"},
+		{TeamID: 9, UserID: "u2"},
+	}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	// the other team of u1 may have PRs waiting for a reviewer
+	mockSeats := mocks.NewSeatFiller(t)
+	mockSeats.On("Wake", 1, 9).Return().Once()
+
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil, mockSeats)
+	_, e := teamSvc.Add(ctx, "platform", users)
+
+	assert.NoError(t, e)
+}
+
 func TestTeamService_Get_Success(t *testing.T) {
 	ctx := context.Background()
 	mockTeamRepo := mocks.NewTeamProvider(t)
@@ -90,14 +139,14 @@ func TestTeamService_Get_Success(t *testing.T) {
 	teamName := "frontend-guild"
 	teamEntity := &entity.Team{ID: 99, Name: teamName}
 	users := []*entity.User{
-		{ID: "frontend-lead", Name: "Leonid", TeamID: 99, IsActive: true},
-		{ID: "senior-frontend", Name: "Olga", TeamID: 99, IsActive: false},
+		{ID: "frontend-lead", Name: "Leonid", IsActive: true},
+		{ID: "senior-frontend", Name: "Olga", IsActive: false},
 	}
 
 	mockTeamRepo.On("GetByTeamName", ctx, teamName).Return(teamEntity, nil)
 	mockUserRepo.On("GetUsersInTeam", ctx, teamName).Return(users, nil)
 
-	teamSvc := team.NewTeamService(nil, mockTeamRepo, mockUserRepo, nil)
+	teamSvc := team.NewTeamService(nil, mockTeamRepo, mockUserRepo, nil, nil)
 	result, e := teamSvc.Get(ctx, teamName)
 
 	assert.NoError(t, e)
@@ -112,7 +161,7 @@ func TestTeamService_Get_NotFound(t *testing.T) {
 
 	mockTeamRepo.On("GetByTeamName", ctx, teamName).Return((*entity.Team)(nil), repo.ErrNotFound)
 
-	teamSvc := team.NewTeamService(nil, mockTeamRepo, nil, nil)
+	teamSvc := team.NewTeamService(nil, mockTeamRepo, nil, nil, nil)
 	result, e := teamSvc.Get(ctx, teamName)
 
 	assert.Nil(t, result)
@@ -136,11 +185,13 @@ func TestTeamService_Add_SaveError(t *testing.T) {
 	storageError := errors.New("storage error")
 
 	mockTeamRepo.On("Create", ctx, teamName).Return(teamID, nil)
+	mockUserRepo.On("GetById", ctx, mock.Anything).Return((*entity.User)(nil), repo.ErrNotFound).Twice()
 	mockUserRepo.On("Save", ctx, mock.MatchedBy(func(u *entity.User) bool {
-		return u.ID == "usr-a-1" && u.Name == "Boris" && u.TeamID == teamID && u.IsActive
+		return u.ID == "usr-a-1" && u.Name == "Boris" && u.IsActive
 	})).Return("", nil)
+	mockUserRepo.On("AddToTeam", ctx, "usr-a-1", teamID).Return(nil).Once()
 	mockUserRepo.On("Save", ctx, mock.MatchedBy(func(u *entity.User) bool {
-		return u.ID == "usr-b-2" && u.Name == "Konstantin" && u.TeamID == teamID && u.IsActive
+		return u.ID == "usr-b-2" && u.Name == "Konstantin" && u.IsActive
 	})).Return("", storageError)
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
@@ -153,7 +204,7 @@ func TestTeamService_Add_SaveError(t *testing.T) {
 		Return(storageError).
 		Once()
 
-	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil)
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil, nil)
 	result, e := teamSvc.Add(ctx, teamName, users)
 
 	assert.Nil(t, result)
@@ -172,10 +223,459 @@ func TestTeamService_Get_GetUsersError(t *testing.T) {
 	mockTeamRepo.On("GetByTeamName", ctx, teamName).Return(teamEntity, nil)
 	mockUserRepo.On("GetUsersInTeam", ctx, teamName).Return(([]*entity.User)(nil), fetchError)
 
-	teamSvc := team.NewTeamService(nil, mockTeamRepo, mockUserRepo, nil)
+	teamSvc := team.NewTeamService(nil, mockTeamRepo, mockUserRepo, nil, nil)
 	result, e := teamSvc.Get(ctx, teamName)
 
 	assert.Nil(t, result)
 	assert.Error(t, e)
 	assert.ErrorIs(t, e, fetchError)
 }
+
+func TestTeamService_Sync_DryRunOnlyPlans(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockUserRepo := mocks.NewUserProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	roster := &dto.Roster{Teams: []dto.RosterTeam{
+		{TeamName: "backend", Members: []dto.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+		{TeamName: "frontend", Members: []dto.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u3", Username: "Carol", IsActive: true},
+		}},
+	}}
+
+	mockTeamRepo.On("List", ctx).Return([]*entity.Team{{ID: 1, Name: "backend"}}, nil).Once()
+	mockUserRepo.On("GetAll", ctx).Return([]*entity.User{
+		{ID: "u1", Name: "Alice", IsActive: true},
+		{ID: "u2", Name: "Bob", IsActive: true},
+	}, nil).Once()
+	mockTeamRepo.On("GetMemberships", ctx).Return([]*entity.TeamMembership{
+		{TeamID: 1, TeamName: "backend", UserID: "u1"},
+		{TeamID: 1, TeamName: "backend", UserID: "u2"},
+	}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil, nil)
+	result, e := teamSvc.Sync(ctx, roster, team.SyncOptions{DryRun: true})
+
+	assert.NoError(t, e)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, []dto.SyncChange{
+		{Action: team.ActionCreateTeam, TeamName: "frontend"},
+		{Action: team.ActionCreateUser, UserID: "u3"},
+		{Action: team.ActionJoinTeam, UserID: "u1", TeamName: "frontend"},
+		{Action: team.ActionJoinTeam, UserID: "u3", TeamName: "frontend"},
+		{Action: team.ActionDeactivateUser, UserID: "u2"},
+	}, result.Changes)
+	mockTeamRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "SetIsActive", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTeamService_Sync_PartialRosterKeepsTheRest(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockUserRepo := mocks.NewUserProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	roster := &dto.Roster{Teams: []dto.RosterTeam{
+		{TeamName: "backend", Members: []dto.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+	}}
+
+	mockTeamRepo.On("List", ctx).Return([]*entity.Team{{ID: 1, Name: "backend"}, {ID: 2, Name: "ops"}}, nil).Once()
+	mockUserRepo.On("GetAll", ctx).Return([]*entity.User{
+		{ID: "u1", Name: "Alice", IsActive: false},
+		{ID: "u2", Name: "Bob", IsActive: true},
+		{ID: "u3", Name: "Carol", IsActive: true},
+	}, nil).Once()
+	mockTeamRepo.On("GetMemberships", ctx).Return([]*entity.TeamMembership{
+		{TeamID: 1, TeamName: "backend", UserID: "u1"},
+		{TeamID: 2, TeamName: "ops", UserID: "u1"},
+		{TeamID: 2, TeamName: "ops", UserID: "u2"},
+	}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil, nil)
+	result, e := teamSvc.Sync(ctx, roster, team.SyncOptions{
+		DryRun:          true,
+		OnlyListedTeams: true,
+		KeepUsers:       []string{"u1", "u2"},
+		KeepIsActive:    true,
+	})
+
+	// u1 stays inactive and in ops, u2 is still in the directory, u3 is gone
+	assert.NoError(t, e)
+	assert.Equal(t, []dto.SyncChange{
+		{Action: team.ActionDeactivateUser, UserID: "u3"},
+	}, result.Changes)
+}
+
+func TestTeamService_Sync_TooManyDeactivations(t *testing.T) {
+	// a dry run is how a roster gets checked, it has to fail the same way
+	for _, dryRun := range []bool{false, true} {
+		t.Run(fmt.Sprintf("dry_run=%t", dryRun), func(t *testing.T) {
+			ctx := context.Background()
+			mockTeamRepo := mocks.NewTeamProvider(t)
+			mockUserRepo := mocks.NewUserProvider(t)
+			mockTx := &mocks.MockManager{}
+			mockTx.Test(t)
+			t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+			roster := &dto.Roster{Teams: []dto.RosterTeam{
+				{TeamName: "backend", Members: []dto.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+			}}
+
+			mockTeamRepo.On("List", ctx).Return([]*entity.Team{{ID: 1, Name: "backend"}}, nil).Once()
+			mockUserRepo.On("GetAll", ctx).Return([]*entity.User{
+				{ID: "u1", Name: "Alice", IsActive: true},
+				{ID: "u2", Name: "Bob", IsActive: true},
+				{ID: "u3", Name: "Carol", IsActive: true},
+			}, nil).Once()
+			mockTeamRepo.On("GetMemberships", ctx).Return([]*entity.TeamMembership{
+				{TeamID: 1, TeamName: "backend", UserID: "u1"},
+			}, nil).Once()
+
+			mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+				Run(func(args mock.Arguments) {
+					fn := args.Get(1).(func(context.Context) error)
+					assert.ErrorIs(t, fn(ctx), repo.ErrTooManyDeactivations)
+				}).
+				Return(repo.ErrTooManyDeactivations).Once()
+
+			teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil, nil)
+			result, e := teamSvc.Sync(ctx, roster, team.SyncOptions{DryRun: dryRun, MaxDeactivations: 1})
+
+			assert.ErrorIs(t, e, repo.ErrTooManyDeactivations)
+			assert.Nil(t, result)
+			mockUserRepo.AssertNotCalled(t, "SetIsActive", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestTeamService_Sync_MoveReassignsReviews(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockUserRepo := mocks.NewUserProvider(t)
+	mockPrRepo := mocks.NewPrProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	roster := &dto.Roster{Teams: []dto.RosterTeam{
+		{TeamName: "frontend", Members: []dto.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+	}}
+
+	mockTeamRepo.On("List", ctx).Return([]*entity.Team{{ID: 1, Name: "backend"}, {ID: 2, Name: "frontend"}}, nil).Once()
+	mockUserRepo.On("GetAll", ctx).Return([]*entity.User{{ID: "u1", Name: "Alice", IsActive: true}}, nil).Once()
+	mockTeamRepo.On("GetMemberships", ctx).Return([]*entity.TeamMembership{
+		{TeamID: 1, TeamName: "backend", UserID: "u1"},
+	}, nil).Once()
+
+	mockUserRepo.On("AddToTeam", ctx, "u1", 2).Return(nil).Once()
+	mockUserRepo.On("RemoveFromTeam", ctx, "u1", 1).Return(nil).Once()
+
+	mockPrRepo.On("GetUserReviews", ctx, "u1").Return([]*entity.PullRequest{
+		{ID: "pr-backend", AuthorId: "author-b", TeamID: 1, Status: "OPEN"},
+		{ID: "pr-frontend", AuthorId: "author-f", TeamID: 2, Status: "OPEN"},
+	}, nil).Once()
+	mockUserRepo.On("GetActiveUsersIDInTeam", ctx, 1).Return([]string{"author-b", "u5"}, nil).Once()
+	mockPrRepo.On("GetPrReviewers", ctx, "pr-backend").Return([]string{"u1"}, nil).Once()
+	mockPrRepo.On("ReassignReviewer", ctx, "pr-backend", "u1", "u5", entity.UnassignLeftTeam).Return(nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, mockPrRepo, nil)
+	result, e := teamSvc.Sync(ctx, roster, team.SyncOptions{})
+
+	assert.NoError(t, e)
+	assert.Equal(t, []dto.SyncChange{
+		{Action: team.ActionJoinTeam, UserID: "u1", TeamName: "frontend"},
+		{Action: team.ActionLeaveTeam, UserID: "u1", TeamName: "backend"},
+	}, result.Changes)
+	assert.Equal(t, []dto.ReassignedReview{
+		{PullRequestID: "pr-backend", OldReviewerID: "u1", ReplacedBy: "u5", Reason: entity.UnassignLeftTeam},
+	}, result.ReassignedReviews)
+}
+
+func TestTeamService_Sync_InvalidRoster(t *testing.T) {
+	tests := []struct {
+		name   string
+		roster *dto.Roster
+	}{
+		{
+			name: "user described differently",
+			roster: &dto.Roster{Teams: []dto.RosterTeam{
+				{TeamName: "backend", Members: []dto.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+				{TeamName: "frontend", Members: []dto.TeamMember{{UserID: "u1", Username: "Alice", IsActive: false}}},
+			}},
+		},
+		{
+			// would deactivate everybody
+			name:   "no teams",
+			roster: &dto.Roster{Teams: []dto.RosterTeam{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamSvc := team.NewTeamService(nil, nil, nil, nil, nil)
+			result, e := teamSvc.Sync(context.Background(), tt.roster, team.SyncOptions{})
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, e, repo.ErrInvalidRoster)
+		})
+	}
+}
+
+func TestTeamService_Import_CreatesMissingTeamsAndUsers(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockUserRepo := mocks.NewUserProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	rows := []dto.TeamRow{
+		{TeamName: "backend", UserID: "u1", Username: "Alice", IsActive: true},
+		{TeamName: "analytics", UserID: "u1", Username: "Alice", IsActive: true},
+		{TeamName: "analytics", UserID: "u2", Username: "Bob", IsActive: true},
+	}
+
+	mockTeamRepo.On("GetByTeamName", ctx, "backend").Return(&entity.Team{ID: 1, Name: "backend"}, nil).Once()
+	mockTeamRepo.On("GetByTeamName", ctx, "analytics").Return((*entity.Team)(nil), repo.ErrNotFound).Once()
+	mockTeamRepo.On("Create", ctx, "analytics").Return(5, nil).Once()
+
+	mockUserRepo.On("GetById", ctx, "u1").Return(&entity.User{ID: "u1", Name: "Alice", IsActive: true}, nil).Once()
+	mockUserRepo.On("GetById", ctx, "u2").Return((*entity.User)(nil), repo.ErrNotFound).Once()
+	mockUserRepo.On("Save", ctx, mock.AnythingOfType("*entity.User")).Return("", nil).Twice()
+	mockUserRepo.On("AddToTeam", ctx, "u1", 1).Return(nil).Once()
+	mockUserRepo.On("AddToTeam", ctx, "u1", 5).Return(nil).Once()
+	mockUserRepo.On("AddToTeam", ctx, "u2", 5).Return(nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	// both teams got active members, their pending seats may be filled now
+	mockSeats := mocks.NewSeatFiller(t)
+	mockSeats.On("Wake", 1, 5).Return().Once()
+
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil, mockSeats)
+	result, e := teamSvc.Import(ctx, rows)
+
+	assert.NoError(t, e)
+	assert.Equal(t, 3, result.Rows)
+	assert.Equal(t, []string{"analytics"}, result.TeamsCreated)
+	assert.Equal(t, 1, result.UsersCreated)
+	assert.Equal(t, 0, result.UsersUpdated)
+}
+
+func TestTeamService_Import_FailureAbortsTransaction(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockUserRepo := mocks.NewUserProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+	rows := []dto.TeamRow{{TeamName: "backend", UserID: "u1", Username: "Alice", IsActive: true}}
+	storageError := errors.New("storage error")
+
+	mockTeamRepo.On("GetByTeamName", ctx, "backend").Return(&entity.Team{ID: 1, Name: "backend"}, nil).Once()
+	mockUserRepo.On("GetById", ctx, "u1").Return((*entity.User)(nil), repo.ErrNotFound).Once()
+	mockUserRepo.On("Save", ctx, mock.AnythingOfType("*entity.User")).Return("", storageError).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.ErrorIs(t, fn(ctx), storageError)
+		}).
+		Return(storageError).Once()
+
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, mockUserRepo, nil, nil)
+	result, e := teamSvc.Import(ctx, rows)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, e, storageError)
+	mockUserRepo.AssertNotCalled(t, "AddToTeam", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTeamService_Export_FiltersByTeam(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockUserRepo := mocks.NewUserProvider(t)
+
+	mockTeamRepo.On("GetByTeamName", ctx, "backend").Return(&entity.Team{ID: 1, Name: "backend"}, nil).Once()
+	mockTeamRepo.On("GetMemberships", ctx).Return([]*entity.TeamMembership{
+		{TeamID: 1, TeamName: "backend", UserID: "u1"},
+		{TeamID: 2, TeamName: "frontend", UserID: "u2"},
+	}, nil).Once()
+	mockUserRepo.On("GetAll", ctx).Return([]*entity.User{
+		{ID: "u1", Name: "Alice", IsActive: true},
+		{ID: "u2", Name: "Bob", IsActive: false},
+	}, nil).Once()
+
+	teamSvc := team.NewTeamService(nil, mockTeamRepo, mockUserRepo, nil, nil)
+	rows, e := teamSvc.Export(ctx, "backend")
+
+	assert.NoError(t, e)
+	assert.Equal(t, []dto.TeamRow{{TeamName: "backend", UserID: "u1", Username: "Alice", IsActive: true}}, rows)
+}
+
+func TestTeamService_SetSizeTiers_Invalid(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	lines := 100
+
+	teamSvc := team.NewTeamService(nil, mockTeamRepo, nil, nil, nil)
+
+	cases := map[string][]dto.SizeTier{
+		"too many seniors":    {{MaxLines: &lines, Reviewers: 1, SeniorReviewers: 2}},
+		"duplicate max_lines": {{MaxLines: &lines, Reviewers: 1}, {MaxLines: &lines, Reviewers: 2}},
+		"two unbounded tiers": {{Reviewers: 1}, {Reviewers: 2}},
+	}
+	for name, tiers := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, e := teamSvc.SetSizeTiers(ctx, "backend", tiers)
+
+			assert.ErrorIs(t, e, repo.ErrInvalidSizeTiers)
+			assert.Nil(t, result)
+		})
+	}
+	mockTeamRepo.AssertNotCalled(t, "ReplaceSizeTiers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTeamService_SetSizeTiers_Success(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	small := 50
+
+	tiers := []dto.SizeTier{{MaxLines: &small, Reviewers: 1}, {Reviewers: 3, SeniorReviewers: 1}}
+	saved := []*entity.SizeTier{
+		{TeamID: 1, MaxLines: &small, Reviewers: 1},
+		{TeamID: 1, Reviewers: 3, SeniorReviewers: 1},
+	}
+
+	mockTeamRepo.On("GetByTeamName", ctx, "backend").Return(&entity.Team{ID: 1, Name: "backend"}, nil).Once()
+	mockTeamRepo.On("ReplaceSizeTiers", ctx, 1, saved).Return(nil).Once()
+	mockTeamRepo.On("GetSizeTiers", ctx, 1).Return(saved, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, nil, nil, nil)
+	result, e := teamSvc.SetSizeTiers(ctx, "backend", tiers)
+
+	assert.NoError(t, e)
+	assert.Equal(t, "backend", result.TeamName)
+	assert.Equal(t, tiers, result.Tiers)
+}
+
+func TestTeamService_AddRoutingRule_Invalid(t *testing.T) {
+	ctx := context.Background()
+	zero, two := 0, 2
+
+	cases := map[string]dto.RoutingRule{
+		"empty label":           {Label: " ", Action: entity.RuleActionSetReviewers, Reviewers: &two},
+		"unknown action":        {Label: "security", Action: "notify"},
+		"no target team":        {Label: "security", Action: entity.RuleActionAddTeamMember},
+		"target is own team":    {Label: "security", Action: entity.RuleActionAddTeamMember, TargetTeamName: "backend"},
+		"missing target team":   {Label: "security", Action: entity.RuleActionAddTeamMember, TargetTeamName: "ghosts"},
+		"zero reviewers":        {Label: "hotfix", Action: entity.RuleActionSetReviewers, Reviewers: &zero},
+		"reviewers with target": {Label: "hotfix", Action: entity.RuleActionSetReviewers, Reviewers: &two, TargetTeamName: "appsec"},
+	}
+	for name, rule := range cases {
+		t.Run(name, func(t *testing.T) {
+			mockTeamRepo := mocks.NewTeamProvider(t)
+			mockTx := &mocks.MockManager{}
+			mockTx.Test(t)
+			t.Cleanup(func() { mockTx.AssertExpectations(t) })
+
+			mockTeamRepo.On("GetByTeamName", ctx, "backend").Return(&entity.Team{ID: 1, Name: "backend"}, nil).Maybe()
+			mockTeamRepo.On("GetByTeamName", ctx, "ghosts").Return(nil, repo.ErrNotFound).Maybe()
+
+			mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+				Run(func(args mock.Arguments) {
+					fn := args.Get(1).(func(context.Context) error)
+					assert.ErrorIs(t, fn(ctx), repo.ErrInvalidRule)
+				}).
+				Return(repo.ErrInvalidRule).Once()
+
+			teamSvc := team.NewTeamService(mockTx, mockTeamRepo, nil, nil, nil)
+			result, e := teamSvc.AddRoutingRule(ctx, "backend", rule)
+
+			assert.ErrorIs(t, e, repo.ErrInvalidRule)
+			assert.Nil(t, result)
+			mockTeamRepo.AssertNotCalled(t, "CreateRoutingRule", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestTeamService_AddRoutingRule_Success(t *testing.T) {
+	ctx := context.Background()
+	mockTeamRepo := mocks.NewTeamProvider(t)
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	appsecID := 2
+	appsec := "appsec"
+
+	mockTeamRepo.On("GetByTeamName", ctx, "backend").Return(&entity.Team{ID: 1, Name: "backend"}, nil).Once()
+	mockTeamRepo.On("GetByTeamName", ctx, "appsec").Return(&entity.Team{ID: appsecID, Name: "appsec"}, nil).Once()
+	mockTeamRepo.On("CreateRoutingRule", ctx, &entity.RoutingRule{
+		TeamID: 1, Label: "security", Action: entity.RuleActionAddTeamMember, TargetTeamID: &appsecID,
+	}).Return(7, nil).Once()
+	mockTeamRepo.On("GetRoutingRule", ctx, 7).Return(&entity.RoutingRule{
+		ID: 7, TeamID: 1, Label: "security", Action: entity.RuleActionAddTeamMember,
+		TargetTeamID: &appsecID, TargetTeamName: &appsec,
+	}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).Once()
+
+	teamSvc := team.NewTeamService(mockTx, mockTeamRepo, nil, nil, nil)
+	result, e := teamSvc.AddRoutingRule(ctx, "backend", dto.RoutingRule{
+		Label: " security", Action: entity.RuleActionAddTeamMember, TargetTeamName: "appsec",
+	})
+
+	assert.NoError(t, e)
+	assert.Equal(t, dto.RoutingRule{
+		ID: 7, Label: "security", Action: entity.RuleActionAddTeamMember, TargetTeamName: "appsec",
+	}, *result)
+}
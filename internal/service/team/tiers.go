@@ -0,0 +1,120 @@
+package team
+
+import (
+	"context"
+	"fmt"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/tracing"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+func (s *TeamService) GetSizeTiers(ctx context.Context, teamName string) (*dto.SizeTiersResponse, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.GetSizeTiers")
+	defer span.End()
+
+	team, err := s.teamProvider.GetByTeamName(ctx, teamName)
+	if err != nil {
+		return nil, service.With(err, "team_name", teamName)
+	}
+
+	tiers, err := s.teamProvider.GetSizeTiers(ctx, team.ID)
+	if err != nil {
+		return nil, service.With(err, "team_name", teamName)
+	}
+
+	return toSizeTiersResponse(teamName, tiers), nil
+}
+
+// SetSizeTiers replaces the team's tiers, an empty list brings back the
+// default of two reviewers for every PR.
+func (s *TeamService) SetSizeTiers(ctx context.Context, teamName string, tiers []dto.SizeTier) (*dto.SizeTiersResponse, error) {
+	ctx, span := tracing.Start(ctx, "TeamService.SetSizeTiers")
+	defer span.End()
+
+	if err := validateSizeTiers(tiers); err != nil {
+		return nil, err
+	}
+
+	var resp *dto.SizeTiersResponse
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamProvider.GetByTeamName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		entities := make([]*entity.SizeTier, 0, len(tiers))
+		for _, t := range tiers {
+			entities = append(entities, &entity.SizeTier{
+				TeamID:          team.ID,
+				MaxLines:        t.MaxLines,
+				Reviewers:       t.Reviewers,
+				SeniorReviewers: t.SeniorReviewers,
+			})
+		}
+
+		if err := s.teamProvider.ReplaceSizeTiers(ctx, team.ID, entities); err != nil {
+			return err
+		}
+
+		saved, err := s.teamProvider.GetSizeTiers(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+
+		resp = toSizeTiersResponse(teamName, saved)
+		return nil
+	})
+	if err != nil {
+		return nil, service.With(err, "team_name", teamName)
+	}
+
+	return resp, nil
+}
+
+func validateSizeTiers(tiers []dto.SizeTier) error {
+	seen := make(map[int]struct{}, len(tiers))
+	unbounded := false
+
+	for _, t := range tiers {
+		if t.SeniorReviewers > t.Reviewers {
+			return service.Fail(repo.ErrInvalidSizeTiers, "senior_reviewers is more than reviewers")
+		}
+
+		if t.MaxLines == nil {
+			if unbounded {
+				return service.Fail(repo.ErrInvalidSizeTiers, "only one tier may omit max_lines")
+			}
+			unbounded = true
+			continue
+		}
+
+		if *t.MaxLines <= 0 {
+			return service.Fail(repo.ErrInvalidSizeTiers, "max_lines must be positive")
+		}
+		if _, ok := seen[*t.MaxLines]; ok {
+			return service.Fail(repo.ErrInvalidSizeTiers, fmt.Sprintf("max_lines %d is used twice", *t.MaxLines), "max_lines", *t.MaxLines)
+		}
+		seen[*t.MaxLines] = struct{}{}
+	}
+
+	return nil
+}
+
+func toSizeTiersResponse(teamName string, tiers []*entity.SizeTier) *dto.SizeTiersResponse {
+	resp := &dto.SizeTiersResponse{
+		TeamName: teamName,
+		Tiers:    make([]dto.SizeTier, 0, len(tiers)),
+	}
+	for _, t := range tiers {
+		resp.Tiers = append(resp.Tiers, dto.SizeTier{
+			MaxLines:        t.MaxLines,
+			Reviewers:       t.Reviewers,
+			SeniorReviewers: t.SeniorReviewers,
+		})
+	}
+	return resp
+}
@@ -2,51 +2,90 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib"
+	"railgorail/avito/internal/metrics"
+	"railgorail/avito/internal/repo"
 	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/tracing"
 	"railgorail/avito/internal/transport/http/dto"
 )
 
+const (
+	statusOpen = "OPEN"
+
+	// ReviewStatePending is a review the reviewer still owes, ReviewStateDone
+	// is one on a PR that got merged.
+	ReviewStatePending = "PENDING"
+	ReviewStateDone    = "DONE"
+)
+
 //go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=PrProvider
 type PrProvider interface {
 	GetUserReviews(ctx context.Context, userID string) ([]*entity.PullRequest, error)
+	ListUserReviews(ctx context.Context, filter entity.ReviewFilter) ([]*entity.Review, error)
+	GetById(ctx context.Context, prID string) (*entity.PullRequest, error)
+	GetPrReviewers(ctx context.Context, prID string) ([]string, error)
+	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID, reason string) error
+	DeleteReviewer(ctx context.Context, prID, userID, reason string) error
 }
 
-//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=TeamIDProvider
-type TeamIDProvider interface {
-	GetTeamNameByID(ctx context.Context, teamID int) (string, error)
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=TeamsProvider
+type TeamsProvider interface {
+	GetTeamsByUserID(ctx context.Context, userID string) ([]*entity.Team, error)
+	GetTeamsByUserIDs(ctx context.Context, userIDs []string) (map[string][]*entity.Team, error)
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=UserChanger
 type UserChanger interface {
 	SetIsActive(ctx context.Context, userID string, isActive bool) error
 	GetById(ctx context.Context, userID string) (*entity.User, error)
+	GetActiveUsersIDInTeam(ctx context.Context, teamID int) ([]string, error)
+	List(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error)
+	UpdateName(ctx context.Context, userID, name string) error
+	SetIsSenior(ctx context.Context, userID string, isSenior bool) error
+	Delete(ctx context.Context, userID string) error
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name=SeatFiller
+type SeatFiller interface {
+	Wake(teamIDs ...int)
 }
 
 type UserService struct {
-	trm            service.TransactionManager
-	prProvider     PrProvider
-	userChanger    UserChanger
-	teamIDProvider TeamIDProvider
+	trm           service.TransactionManager
+	prProvider    PrProvider
+	userChanger   UserChanger
+	teamsProvider TeamsProvider
+	seats         SeatFiller
 }
 
+// NewUserService takes seats to fill pending reviewer seats when a user is
+// activated, nil leaves them to the reconciler's poll.
 func NewUserService(
 	trm service.TransactionManager,
 	prProvider PrProvider,
 	userChanger UserChanger,
-	teamIDProvider TeamIDProvider,
+	teamsProvider TeamsProvider,
+	seats SeatFiller,
 ) *UserService {
 	return &UserService{
-		trm:            trm,
-		prProvider:     prProvider,
-		userChanger:    userChanger,
-		teamIDProvider: teamIDProvider,
+		trm:           trm,
+		prProvider:    prProvider,
+		userChanger:   userChanger,
+		teamsProvider: teamsProvider,
+		seats:         seats,
 	}
 }
 
 func (s *UserService) SetIsActive(ctx context.Context, userID string, isActive bool) (*dto.UserSchema, error) {
+	ctx, span := tracing.Start(ctx, "UserService.SetIsActive")
+	defer span.End()
+
 	resp := &dto.UserSchema{}
+	var teamIDs []int
 
 	err := s.trm.Do(ctx, func(ctx context.Context) error {
 		err := s.userChanger.SetIsActive(ctx, userID, isActive)
@@ -59,56 +98,289 @@ func (s *UserService) SetIsActive(ctx context.Context, userID string, isActive b
 			return err
 		}
 
-		teamName, err := s.teamIDProvider.GetTeamNameByID(ctx, user.TeamID)
+		teams, err := s.teamsProvider.GetTeamsByUserID(ctx, user.ID)
 		if err != nil {
 			return err
 		}
+		for _, t := range teams {
+			teamIDs = append(teamIDs, t.ID)
+		}
 
-		resp.UserID = user.ID
-		resp.Username = user.Name
-		resp.TeamName = teamName
-		resp.IsActive = user.IsActive
-
+		*resp = *toUserSchema(user, teams)
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, service.With(err, "user_id", userID)
+	}
+
+	// the user may take pending seats in their teams
+	if isActive {
+		s.wake(teamIDs)
 	}
 	return resp, nil
 }
 
-func (s *UserService) GetReview(ctx context.Context, userID string) (*dto.GetReviewResponse, error) {
+func (s *UserService) wake(teamIDs []int) {
+	if s.seats != nil && len(teamIDs) > 0 {
+		s.seats.Wake(teamIDs...)
+	}
+}
+
+// GetReview returns one page of the user's reviews. The page is read one item
+// longer to know whether next_cursor is needed.
+func (s *UserService) GetReview(ctx context.Context, filter entity.ReviewFilter) (*dto.GetReviewResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.GetReview")
+	defer span.End()
+
 	resp := &dto.GetReviewResponse{
-		UserID:       userID,
+		UserID:       filter.UserID,
 		PullRequests: []dto.PullRequestShort{},
 	}
 
+	limit := filter.Limit
+	filter.Limit++
+
 	err := s.trm.Do(ctx, func(ctx context.Context) error {
-		_, err := s.userChanger.GetById(ctx, userID)
+		_, err := s.userChanger.GetById(ctx, filter.UserID)
 		if err != nil {
 			return err
 		}
 
-		prs, err := s.prProvider.GetUserReviews(ctx, userID)
+		reviews, err := s.prProvider.ListUserReviews(ctx, filter)
 		if err != nil {
 			return err
 		}
 
-		for _, pr := range prs {
+		hasMore := len(reviews) > limit
+		if hasMore {
+			reviews = reviews[:limit]
+		}
+
+		for _, review := range reviews {
 			short := dto.PullRequestShort{
-				ID:       pr.ID,
-				Name:     pr.Title,
-				AuthorID: pr.AuthorId,
-				Status:   pr.Status,
+				ID:          review.ID,
+				Name:        review.Title,
+				AuthorID:    review.AuthorId,
+				Status:      review.Status,
+				AssignedAt:  review.AssignedAt,
+				ReviewState: ReviewStateDone,
+			}
+			if review.Status == statusOpen {
+				short.ReviewState = ReviewStatePending
 			}
 
 			resp.PullRequests = append(resp.PullRequests, short)
 		}
 
+		if hasMore {
+			last := reviews[len(reviews)-1]
+			resp.NextCursor = dto.Cursor{
+				Sort:  "assigned_at",
+				Desc:  filter.Desc,
+				Value: last.AssignedAt.UTC().Format(time.RFC3339Nano),
+				ID:    last.ID,
+			}.Encode()
+		}
+
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, service.With(err, "user_id", filter.UserID)
 	}
 	return resp, err
 }
+
+func (s *UserService) Get(ctx context.Context, userID string) (*dto.UserSchema, error) {
+	ctx, span := tracing.Start(ctx, "UserService.Get")
+	defer span.End()
+
+	user, err := s.userChanger.GetById(ctx, userID)
+	if err != nil {
+		return nil, service.With(err, "user_id", userID)
+	}
+
+	teams, err := s.teamsProvider.GetTeamsByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, service.With(err, "user_id", userID)
+	}
+
+	return toUserSchema(user, teams), nil
+}
+
+func (s *UserService) List(ctx context.Context, filter entity.UserFilter) (*dto.UserListResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.List")
+	defer span.End()
+
+	resp := &dto.UserListResponse{
+		Users:  []dto.UserSchema{},
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+
+	users, err := s.userChanger.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return resp, nil
+	}
+
+	userIDs := make([]string, 0, len(users))
+	for _, u := range users {
+		userIDs = append(userIDs, u.ID)
+	}
+
+	teams, err := s.teamsProvider.GetTeamsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		resp.Users = append(resp.Users, *toUserSchema(u, teams[u.ID]))
+	}
+
+	return resp, nil
+}
+
+// Update changes the name when it is not empty and the seniority when
+// isSenior is set.
+func (s *UserService) Update(ctx context.Context, userID, name string, isSenior *bool) (*dto.UserSchema, error) {
+	ctx, span := tracing.Start(ctx, "UserService.Update")
+	defer span.End()
+
+	var resp *dto.UserSchema
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		if name != "" {
+			if err := s.userChanger.UpdateName(ctx, userID, name); err != nil {
+				return err
+			}
+		}
+
+		if isSenior != nil {
+			if err := s.userChanger.SetIsSenior(ctx, userID, *isSenior); err != nil {
+				return err
+			}
+		}
+
+		user, err := s.userChanger.GetById(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		teams, err := s.teamsProvider.GetTeamsByUserID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		resp = toUserSchema(user, teams)
+		return nil
+	})
+	if err != nil {
+		return nil, service.With(err, "user_id", userID)
+	}
+	return resp, nil
+}
+
+// Delete removes the user. OPEN reviews block the deletion unless reassign is set,
+// in which case each of them is handed over to another active member of the
+// PR's team, or simply dropped when there is nobody left to take it.
+func (s *UserService) Delete(ctx context.Context, userID string, reassign bool) (*dto.DeleteUserResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.Delete")
+	defer span.End()
+
+	resp := &dto.DeleteUserResponse{
+		UserID:            userID,
+		ReassignedReviews: []dto.ReassignedReview{},
+	}
+
+	err := s.trm.Do(ctx, func(ctx context.Context) error {
+		_, err := s.userChanger.GetById(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		prs, err := s.prProvider.GetUserReviews(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		var openPrs []*entity.PullRequest
+		for _, pr := range prs {
+			if pr.Status == statusOpen {
+				openPrs = append(openPrs, pr)
+			}
+		}
+
+		if len(openPrs) > 0 && !reassign {
+			return repo.ErrHasOpenReviews
+		}
+
+		for _, pr := range openPrs {
+			replacedBy, err := s.releaseReview(ctx, pr, userID)
+			if err != nil {
+				return err
+			}
+
+			resp.ReassignedReviews = append(resp.ReassignedReviews, dto.ReassignedReview{
+				PullRequestID: pr.ID,
+				ReplacedBy:    replacedBy,
+				Reason:        entity.UnassignUserDeleted,
+			})
+		}
+
+		return s.userChanger.Delete(ctx, userID)
+	})
+	if err != nil {
+		return nil, service.With(err, "user_id", userID)
+	}
+
+	for _, r := range resp.ReassignedReviews {
+		metrics.Released(r.Reason, r.ReplacedBy)
+	}
+	return resp, nil
+}
+
+func (s *UserService) releaseReview(ctx context.Context, pr *entity.PullRequest, userID string) (string, error) {
+	activeUsers, err := s.userChanger.GetActiveUsersIDInTeam(ctx, pr.TeamID)
+	if err != nil {
+		return "", err
+	}
+
+	assignedReviewers, err := s.prProvider.GetPrReviewers(ctx, pr.ID)
+	if err != nil {
+		return "", err
+	}
+
+	excluded := append([]string{pr.AuthorId, userID}, assignedReviewers...)
+	candidates := lib.RandomUsers(activeUsers, 1, excluded...)
+	if len(candidates) == 0 {
+		return "", s.prProvider.DeleteReviewer(ctx, pr.ID, userID, entity.UnassignUserDeleted)
+	}
+
+	err = s.prProvider.ReassignReviewer(ctx, pr.ID, userID, candidates[0], entity.UnassignUserDeleted)
+	if err != nil {
+		return "", err
+	}
+	return candidates[0], nil
+}
+
+func toUserSchema(user *entity.User, teams []*entity.Team) *dto.UserSchema {
+	resp := &dto.UserSchema{
+		UserID:    user.ID,
+		Username:  user.Name,
+		IsActive:  user.IsActive,
+		IsSenior:  user.IsSenior,
+		TeamNames: make([]string, 0, len(teams)),
+	}
+
+	for _, t := range teams {
+		resp.TeamNames = append(resp.TeamNames, t.Name)
+	}
+	if len(teams) > 0 {
+		resp.TeamName = teams[0].Name
+	}
+
+	return resp
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/repo"
 	"railgorail/avito/internal/service/mocks"
 	userservice "railgorail/avito/internal/service/user"
+	"railgorail/avito/internal/transport/http/dto"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,21 +24,23 @@ func TestUserService_SetIsActive_Success(t *testing.T) {
 
 	mockPrRepo := mocks.NewPrProvider(t)
 	mockUserRepo := mocks.NewUserChanger(t)
-	mockTeamRepo := mocks.NewTeamIDProvider(t)
+	mockTeamRepo := mocks.NewTeamsProvider(t)
+	mockSeats := mocks.NewSeatFiller(t)
 
 	userID := "employee-abc"
 	isActive := true
 	userEntity := &entity.User{
 		ID:       userID,
 		Name:     "Anna",
-		TeamID:   420,
 		IsActive: isActive,
 	}
 	teamName := "infra-squad"
 
 	mockUserRepo.On("SetIsActive", ctx, userID, isActive).Return(nil).Once()
 	mockUserRepo.On("GetById", ctx, userID).Return(userEntity, nil).Once()
-	mockTeamRepo.On("GetTeamNameByID", ctx, 420).Return(teamName, nil).Once()
+	mockTeamRepo.On("GetTeamsByUserID", ctx, userID).
+		Return([]*entity.Team{{ID: 420, Name: teamName}, {ID: 421, Name: "oncall"}}, nil).
+		Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -44,8 +49,10 @@ func TestUserService_SetIsActive_Success(t *testing.T) {
 		}).
 		Return(nil).
 		Once()
+	// the returning user may take pending seats in both teams
+	mockSeats.On("Wake", 420, 421).Once()
 
-	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, mockTeamRepo)
+	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, mockTeamRepo, mockSeats)
 	result, e := userSvc.SetIsActive(ctx, userID, isActive)
 
 	assert.NoError(t, e)
@@ -53,6 +60,7 @@ func TestUserService_SetIsActive_Success(t *testing.T) {
 	assert.Equal(t, userID, result.UserID)
 	assert.Equal(t, "Anna", result.Username)
 	assert.Equal(t, teamName, result.TeamName)
+	assert.Equal(t, []string{teamName, "oncall"}, result.TeamNames)
 	assert.True(t, result.IsActive)
 }
 
@@ -79,7 +87,7 @@ func TestUserService_SetIsActive_SetIsActiveError(t *testing.T) {
 		Return(databaseError).
 		Once()
 
-	userSvc := userservice.NewUserService(mockTx, nil, mockUserRepo, nil)
+	userSvc := userservice.NewUserService(mockTx, nil, mockUserRepo, nil, nil)
 	result, e := userSvc.SetIsActive(ctx, userID, isActive)
 
 	assert.Nil(t, result)
@@ -111,7 +119,7 @@ func TestUserService_SetIsActive_GetByIdError(t *testing.T) {
 		Return(databaseError).
 		Once()
 
-	userSvc := userservice.NewUserService(mockTx, nil, mockUserRepo, nil)
+	userSvc := userservice.NewUserService(mockTx, nil, mockUserRepo, nil, nil)
 	result, e := userSvc.SetIsActive(ctx, userID, isActive)
 
 	assert.Nil(t, result)
@@ -119,24 +127,23 @@ func TestUserService_SetIsActive_GetByIdError(t *testing.T) {
 	assert.ErrorIs(t, e, databaseError)
 }
 
-func TestUserService_SetIsActive_GetTeamNameByIDError(t *testing.T) {
+func TestUserService_SetIsActive_GetTeamsByUserIDError(t *testing.T) {
 	ctx := context.Background()
 	mockTx := &mocks.MockManager{}
 	mockTx.Test(t)
 	t.Cleanup(func() { mockTx.AssertExpectations(t) })
 	mockUserRepo := mocks.NewUserChanger(t)
-	mockTeamRepo := mocks.NewTeamIDProvider(t)
+	mockTeamRepo := mocks.NewTeamsProvider(t)
 
 	userID := "employee-abc"
 	isActive := true
-	teamID := 999
 	databaseError := errors.New("could not find team")
 
 	mockUserRepo.On("SetIsActive", ctx, userID, isActive).Return(nil).Once()
 	mockUserRepo.On("GetById", ctx, userID).
-		Return(&entity.User{ID: userID, Name: "Boris", TeamID: teamID, IsActive: isActive}, nil).
+		Return(&entity.User{ID: userID, Name: "Boris", IsActive: isActive}, nil).
 		Once()
-	mockTeamRepo.On("GetTeamNameByID", ctx, teamID).Return("", databaseError).Once()
+	mockTeamRepo.On("GetTeamsByUserID", ctx, userID).Return(([]*entity.Team)(nil), databaseError).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -148,7 +155,7 @@ func TestUserService_SetIsActive_GetTeamNameByIDError(t *testing.T) {
 		Return(databaseError).
 		Once()
 
-	userSvc := userservice.NewUserService(mockTx, nil, mockUserRepo, mockTeamRepo)
+	userSvc := userservice.NewUserService(mockTx, nil, mockUserRepo, mockTeamRepo, nil)
 	result, e := userSvc.SetIsActive(ctx, userID, isActive)
 
 	assert.Nil(t, result)
@@ -165,23 +172,33 @@ func TestUserService_GetReview_Success_WithPRs(t *testing.T) {
 	mockUserRepo := mocks.NewUserChanger(t)
 
 	userID := "employee-def"
-	pullRequests := []*entity.PullRequest{
+	assignedAt := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	reviews := []*entity.Review{
 		{
-			ID:       "pr-alpha-1",
-			Title:    "feat: implement login form",
-			AuthorId: userID,
-			Status:   "OPEN",
+			PullRequest: entity.PullRequest{
+				ID:       "pr-alpha-1",
+				Title:    "feat: implement login form",
+				AuthorId: userID,
+				Status:   "OPEN",
+			},
+			AssignedAt: &assignedAt,
 		},
 		{
-			ID:       "pr-beta-2",
-			Title:    "test: cover login service",
-			AuthorId: userID,
-			Status:   "MERGED",
+			PullRequest: entity.PullRequest{
+				ID:       "pr-beta-2",
+				Title:    "test: cover login service",
+				AuthorId: userID,
+				Status:   "MERGED",
+			},
+			AssignedAt: &assignedAt,
 		},
 	}
+	filter := entity.ReviewFilter{UserID: userID, Statuses: []string{"OPEN", "MERGED"}, Limit: 50}
+	expectedFilter := filter
+	expectedFilter.Limit = 51
 
 	mockUserRepo.On("GetById", ctx, userID).Return(&entity.User{ID: userID}, nil).Once()
-	mockPrRepo.On("GetUserReviews", ctx, userID).Return(pullRequests, nil).Once()
+	mockPrRepo.On("ListUserReviews", ctx, expectedFilter).Return(reviews, nil).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -191,8 +208,8 @@ func TestUserService_GetReview_Success_WithPRs(t *testing.T) {
 		Return(nil).
 		Once()
 
-	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil)
-	result, e := userSvc.GetReview(ctx, userID)
+	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil, nil)
+	result, e := userSvc.GetReview(ctx, filter)
 
 	assert.NoError(t, e)
 	assert.NotNil(t, result)
@@ -202,9 +219,54 @@ func TestUserService_GetReview_Success_WithPRs(t *testing.T) {
 	assert.Equal(t, "feat: implement login form", result.PullRequests[0].Name)
 	assert.Equal(t, userID, result.PullRequests[0].AuthorID)
 	assert.Equal(t, "OPEN", result.PullRequests[0].Status)
+	assert.Equal(t, userservice.ReviewStatePending, result.PullRequests[0].ReviewState)
+	assert.Equal(t, &assignedAt, result.PullRequests[0].AssignedAt)
 	assert.Equal(t, "pr-beta-2", result.PullRequests[1].ID)
 	assert.Equal(t, "test: cover login service", result.PullRequests[1].Name)
 	assert.Equal(t, "MERGED", result.PullRequests[1].Status)
+	assert.Equal(t, userservice.ReviewStateDone, result.PullRequests[1].ReviewState)
+	assert.Empty(t, result.NextCursor)
+}
+
+func TestUserService_GetReview_ReturnsNextCursor(t *testing.T) {
+	ctx := context.Background()
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	mockPrRepo := mocks.NewPrProvider(t)
+	mockUserRepo := mocks.NewUserChanger(t)
+
+	userID := "employee-pqr"
+	first := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+	filter := entity.ReviewFilter{UserID: userID, Statuses: []string{"OPEN"}, Limit: 1}
+	expectedFilter := filter
+	expectedFilter.Limit = 2
+
+	mockUserRepo.On("GetById", ctx, userID).Return(&entity.User{ID: userID}, nil).Once()
+	mockPrRepo.On("ListUserReviews", ctx, expectedFilter).Return([]*entity.Review{
+		{PullRequest: entity.PullRequest{ID: "pr-1", Status: "OPEN"}, AssignedAt: &first},
+		{PullRequest: entity.PullRequest{ID: "pr-2", Status: "OPEN"}, AssignedAt: &second},
+	}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).
+		Once()
+
+	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil, nil)
+	result, e := userSvc.GetReview(ctx, filter)
+
+	assert.NoError(t, e)
+	assert.Len(t, result.PullRequests, 1)
+
+	cursor, err := dto.DecodeCursor(result.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, "pr-1", cursor.ID)
+	assert.Equal(t, first.Format(time.RFC3339Nano), cursor.Value)
 }
 
 func TestUserService_GetReview_Success_NoPRs(t *testing.T) {
@@ -216,9 +278,10 @@ func TestUserService_GetReview_Success_NoPRs(t *testing.T) {
 	mockUserRepo := mocks.NewUserChanger(t)
 
 	userID := "employee-ghi"
+	filter := entity.ReviewFilter{UserID: userID, Statuses: []string{"OPEN"}, Limit: 50}
 
 	mockUserRepo.On("GetById", ctx, userID).Return(&entity.User{ID: userID}, nil).Once()
-	mockPrRepo.On("GetUserReviews", ctx, userID).Return([]*entity.PullRequest{}, nil).Once()
+	mockPrRepo.On("ListUserReviews", ctx, mock.AnythingOfType("entity.ReviewFilter")).Return([]*entity.Review{}, nil).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -228,8 +291,8 @@ func TestUserService_GetReview_Success_NoPRs(t *testing.T) {
 		Return(nil).
 		Once()
 
-	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil)
-	result, e := userSvc.GetReview(ctx, userID)
+	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil, nil)
+	result, e := userSvc.GetReview(ctx, filter)
 
 	assert.NoError(t, e)
 	assert.NotNil(t, result)
@@ -246,6 +309,7 @@ func TestUserService_GetReview_GetByIdError(t *testing.T) {
 	mockUserRepo := mocks.NewUserChanger(t)
 
 	userID := "employee-jkl"
+	filter := entity.ReviewFilter{UserID: userID, Statuses: []string{"OPEN"}, Limit: 50}
 	databaseError := errors.New("could not find user")
 
 	mockUserRepo.On("GetById", ctx, userID).Return((*entity.User)(nil), databaseError).Once()
@@ -260,8 +324,8 @@ func TestUserService_GetReview_GetByIdError(t *testing.T) {
 		Return(databaseError).
 		Once()
 
-	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil)
-	result, e := userSvc.GetReview(ctx, userID)
+	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil, nil)
+	result, e := userSvc.GetReview(ctx, filter)
 
 	assert.Nil(t, result)
 	assert.Error(t, e)
@@ -277,10 +341,11 @@ func TestUserService_GetReview_PrProviderError(t *testing.T) {
 	mockUserRepo := mocks.NewUserChanger(t)
 
 	userID := "employee-mno"
+	filter := entity.ReviewFilter{UserID: userID, Statuses: []string{"OPEN"}, Limit: 50}
 	prError := errors.New("pr service is down")
 
 	mockUserRepo.On("GetById", ctx, userID).Return(&entity.User{ID: userID}, nil).Once()
-	mockPrRepo.On("GetUserReviews", ctx, userID).Return(([]*entity.PullRequest)(nil), prError).Once()
+	mockPrRepo.On("ListUserReviews", ctx, mock.AnythingOfType("entity.ReviewFilter")).Return(([]*entity.Review)(nil), prError).Once()
 
 	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -292,10 +357,172 @@ func TestUserService_GetReview_PrProviderError(t *testing.T) {
 		Return(prError).
 		Once()
 
-	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil)
-	result, e := userSvc.GetReview(ctx, userID)
+	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil, nil)
+	result, e := userSvc.GetReview(ctx, filter)
 
 	assert.Nil(t, result)
 	assert.Error(t, e)
 	assert.ErrorIs(t, e, prError)
 }
+
+func TestUserService_Get_Success(t *testing.T) {
+	ctx := context.Background()
+	mockUserRepo := mocks.NewUserChanger(t)
+	mockTeamRepo := mocks.NewTeamsProvider(t)
+
+	userID := "employee-pqr"
+	mockUserRepo.On("GetById", ctx, userID).
+		Return(&entity.User{ID: userID, Name: "Vera", IsActive: true}, nil).
+		Once()
+	mockTeamRepo.On("GetTeamsByUserID", ctx, userID).Return([]*entity.Team{{ID: 7, Name: "platform"}}, nil).Once()
+
+	userSvc := userservice.NewUserService(nil, nil, mockUserRepo, mockTeamRepo, nil)
+	result, e := userSvc.Get(ctx, userID)
+
+	assert.NoError(t, e)
+	assert.Equal(t, userID, result.UserID)
+	assert.Equal(t, "Vera", result.Username)
+	assert.Equal(t, "platform", result.TeamName)
+	assert.True(t, result.IsActive)
+}
+
+func TestUserService_List_Success(t *testing.T) {
+	ctx := context.Background()
+	mockUserRepo := mocks.NewUserChanger(t)
+	mockTeamRepo := mocks.NewTeamsProvider(t)
+
+	isActive := true
+	filter := entity.UserFilter{IsActive: &isActive, Limit: 10}
+	users := []*entity.User{
+		{ID: "u1", Name: "Alice", IsActive: true},
+		{ID: "u2", Name: "Bob", IsActive: true},
+		{ID: "u3", Name: "Carol", IsActive: true},
+	}
+	backend := &entity.Team{ID: 1, Name: "backend"}
+	frontend := &entity.Team{ID: 2, Name: "frontend"}
+
+	mockUserRepo.On("List", ctx, filter).Return(users, nil).Once()
+	mockTeamRepo.On("GetTeamsByUserIDs", ctx, []string{"u1", "u2", "u3"}).Return(map[string][]*entity.Team{
+		"u1": {backend},
+		"u2": {backend, frontend},
+		"u3": {frontend},
+	}, nil).Once()
+
+	userSvc := userservice.NewUserService(nil, nil, mockUserRepo, mockTeamRepo, nil)
+	result, e := userSvc.List(ctx, filter)
+
+	assert.NoError(t, e)
+	assert.Len(t, result.Users, 3)
+	assert.Equal(t, []string{"backend", "frontend"}, result.Users[1].TeamNames)
+	assert.Equal(t, "frontend", result.Users[2].TeamName)
+	assert.Equal(t, 10, result.Limit)
+}
+
+func TestUserService_Update_Success(t *testing.T) {
+	ctx := context.Background()
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	mockUserRepo := mocks.NewUserChanger(t)
+	mockTeamRepo := mocks.NewTeamsProvider(t)
+
+	userID := "employee-stu"
+	mockUserRepo.On("UpdateName", ctx, userID, "Gleb").Return(nil).Once()
+	mockUserRepo.On("GetById", ctx, userID).
+		Return(&entity.User{ID: userID, Name: "Gleb"}, nil).
+		Once()
+	mockTeamRepo.On("GetTeamsByUserID", ctx, userID).Return([]*entity.Team{{ID: 3, Name: "mobile"}}, nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).
+		Once()
+
+	userSvc := userservice.NewUserService(mockTx, nil, mockUserRepo, mockTeamRepo, nil)
+	result, e := userSvc.Update(ctx, userID, "Gleb", nil)
+
+	assert.NoError(t, e)
+	assert.Equal(t, "Gleb", result.Username)
+	assert.Equal(t, "mobile", result.TeamName)
+}
+
+func TestUserService_Delete_RefusedWithOpenReviews(t *testing.T) {
+	ctx := context.Background()
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	mockPrRepo := mocks.NewPrProvider(t)
+	mockUserRepo := mocks.NewUserChanger(t)
+
+	userID := "employee-vwx"
+	mockUserRepo.On("GetById", ctx, userID).Return(&entity.User{ID: userID}, nil).Once()
+	mockPrRepo.On("GetUserReviews", ctx, userID).
+		Return([]*entity.PullRequest{{ID: "pr-1", AuthorId: "author-1", Status: "OPEN"}}, nil).
+		Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.ErrorIs(t, fn(ctx), repo.ErrHasOpenReviews)
+		}).
+		Return(repo.ErrHasOpenReviews).
+		Once()
+
+	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil, nil)
+	result, e := userSvc.Delete(ctx, userID, false)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, e, repo.ErrHasOpenReviews)
+	mockUserRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestUserService_Delete_ReassignsOpenReviews(t *testing.T) {
+	ctx := context.Background()
+	mockTx := &mocks.MockManager{}
+	mockTx.Test(t)
+	t.Cleanup(func() { mockTx.AssertExpectations(t) })
+	mockPrRepo := mocks.NewPrProvider(t)
+	mockUserRepo := mocks.NewUserChanger(t)
+
+	userID := "employee-yz"
+	reviews := []*entity.PullRequest{
+		{ID: "pr-open-1", AuthorId: "author-1", TeamID: 1, Status: "OPEN"},
+		{ID: "pr-open-2", AuthorId: "author-2", TeamID: 2, Status: "OPEN"},
+		{ID: "pr-merged", AuthorId: "author-1", TeamID: 1, Status: "MERGED"},
+	}
+
+	mockUserRepo.On("GetById", ctx, userID).Return(&entity.User{ID: userID}, nil).Once()
+	mockPrRepo.On("GetUserReviews", ctx, userID).Return(reviews, nil).Once()
+
+	mockUserRepo.On("GetActiveUsersIDInTeam", ctx, 1).
+		Return([]string{"author-1", userID, "rev-a"}, nil).
+		Once()
+	mockPrRepo.On("GetPrReviewers", ctx, "pr-open-1").Return([]string{userID}, nil).Once()
+	mockPrRepo.On("ReassignReviewer", ctx, "pr-open-1", userID, "rev-a", entity.UnassignUserDeleted).Return(nil).Once()
+
+	mockUserRepo.On("GetActiveUsersIDInTeam", ctx, 2).Return([]string{"author-2", userID}, nil).Once()
+	mockPrRepo.On("GetPrReviewers", ctx, "pr-open-2").Return([]string{userID}, nil).Once()
+	mockPrRepo.On("DeleteReviewer", ctx, "pr-open-2", userID, entity.UnassignUserDeleted).Return(nil).Once()
+
+	mockUserRepo.On("Delete", ctx, userID).Return(nil).Once()
+
+	mockTx.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error)
+			assert.NoError(t, fn(ctx))
+		}).
+		Return(nil).
+		Once()
+
+	userSvc := userservice.NewUserService(mockTx, mockPrRepo, mockUserRepo, nil, nil)
+	result, e := userSvc.Delete(ctx, userID, true)
+
+	assert.NoError(t, e)
+	assert.Equal(t, userID, result.UserID)
+	assert.Len(t, result.ReassignedReviews, 2)
+	assert.Equal(t, "rev-a", result.ReassignedReviews[0].ReplacedBy)
+	assert.Empty(t, result.ReassignedReviews[1].ReplacedBy)
+}
@@ -2,19 +2,24 @@ package storage
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 
 	"railgorail/avito/internal/lib/sl"
 
+	"github.com/XSAM/otelsql"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 )
 
+const migrationsURL = "file://./migrations"
+
 type Storage struct {
 	Db *sqlx.DB
 }
@@ -26,11 +31,21 @@ func New(dsn string, log *slog.Logger) (*Storage, error) {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	db, err := sqlx.Connect("postgres", dsn)
+	// every statement gets a span under the request's one
+	sqlDB, err := otelsql.Open("postgres", dsn,
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{OmitConnResetSession: true, OmitRows: true}),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	db := sqlx.NewDb(sqlDB, "postgres")
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return &Storage{Db: db}, nil
 }
 
@@ -67,7 +82,7 @@ func runMigrations(dsn string, log *slog.Logger) error {
 	}
 
 	m, err := migrate.NewWithDatabaseInstance(
-		"file://./migrations",
+		migrationsURL,
 		"postgres",
 		driver,
 	)
@@ -82,3 +97,30 @@ func runMigrations(dsn string, log *slog.Logger) error {
 	log.Info("migrations applied successfully")
 	return nil
 }
+
+// LatestMigration returns the version of the newest migration on disk, the
+// one the database is expected to be at.
+func LatestMigration() (uint, error) {
+	const op = "storage.postgres.LatestMigration"
+
+	src, err := (&file.File{}).Open(migrationsURL)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer src.Close()
+
+	version, err := src.First()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		version = next
+	}
+}
@@ -0,0 +1,3004 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.8.0 DO NOT EDIT.
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for DependencyHealthStatus.
+const (
+	DependencyHealthStatusDown DependencyHealthStatus = "down"
+	DependencyHealthStatusUp   DependencyHealthStatus = "up"
+)
+
+// Valid indicates whether the value is a known member of the DependencyHealthStatus enum.
+func (e DependencyHealthStatus) Valid() bool {
+	switch e {
+	case DependencyHealthStatusDown:
+		return true
+	case DependencyHealthStatusUp:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ErrorCode.
+const (
+	BADREQUEST           ErrorCode = "BAD_REQUEST"
+	EMPTYDIRECTORY       ErrorCode = "EMPTY_DIRECTORY"
+	HASOPENREVIEWS       ErrorCode = "HAS_OPEN_REVIEWS"
+	IDEMPOTENCYKEYREUSED ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALERROR        ErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE          ErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED          ErrorCode = "NOT_ASSIGNED"
+	NOTFOUND             ErrorCode = "NOT_FOUND"
+	NOTTEAMMEMBER        ErrorCode = "NOT_TEAM_MEMBER"
+	PARENTNOTMERGED      ErrorCode = "PARENT_NOT_MERGED"
+	PREXISTS             ErrorCode = "PR_EXISTS"
+	PRMERGED             ErrorCode = "PR_MERGED"
+	REQUESTINPROGRESS    ErrorCode = "REQUEST_IN_PROGRESS"
+	REQUESTTOOLARGE      ErrorCode = "REQUEST_TOO_LARGE"
+	RULEEXISTS           ErrorCode = "RULE_EXISTS"
+	TEAMEXISTS           ErrorCode = "TEAM_EXISTS"
+	TEAMREQUIRED         ErrorCode = "TEAM_REQUIRED"
+	TOOMANYDEACTIVATIONS ErrorCode = "TOO_MANY_DEACTIVATIONS"
+	USERISAUTHOR         ErrorCode = "USER_IS_AUTHOR"
+	VALIDATIONERROR      ErrorCode = "VALIDATION_ERROR"
+)
+
+// Valid indicates whether the value is a known member of the ErrorCode enum.
+func (e ErrorCode) Valid() bool {
+	switch e {
+	case BADREQUEST:
+		return true
+	case EMPTYDIRECTORY:
+		return true
+	case HASOPENREVIEWS:
+		return true
+	case IDEMPOTENCYKEYREUSED:
+		return true
+	case INTERNALERROR:
+		return true
+	case NOCANDIDATE:
+		return true
+	case NOTASSIGNED:
+		return true
+	case NOTFOUND:
+		return true
+	case NOTTEAMMEMBER:
+		return true
+	case PARENTNOTMERGED:
+		return true
+	case PREXISTS:
+		return true
+	case PRMERGED:
+		return true
+	case REQUESTINPROGRESS:
+		return true
+	case REQUESTTOOLARGE:
+		return true
+	case RULEEXISTS:
+		return true
+	case TEAMEXISTS:
+		return true
+	case TEAMREQUIRED:
+		return true
+	case TOOMANYDEACTIVATIONS:
+		return true
+	case USERISAUTHOR:
+		return true
+	case VALIDATIONERROR:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for FairnessOutlierLoad.
+const (
+	Over  FairnessOutlierLoad = "over"
+	Under FairnessOutlierLoad = "under"
+)
+
+// Valid indicates whether the value is a known member of the FairnessOutlierLoad enum.
+func (e FairnessOutlierLoad) Valid() bool {
+	switch e {
+	case Over:
+		return true
+	case Under:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for HealthResponseStatus.
+const (
+	HealthResponseStatusUp HealthResponseStatus = "up"
+)
+
+// Valid indicates whether the value is a known member of the HealthResponseStatus enum.
+func (e HealthResponseStatus) Valid() bool {
+	switch e {
+	case HealthResponseStatusUp:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestStatus enum.
+func (e PullRequestStatus) Valid() bool {
+	switch e {
+	case PullRequestStatusMERGED:
+		return true
+	case PullRequestStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestShortReviewState.
+const (
+	DONE    PullRequestShortReviewState = "DONE"
+	PENDING PullRequestShortReviewState = "PENDING"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestShortReviewState enum.
+func (e PullRequestShortReviewState) Valid() bool {
+	switch e {
+	case DONE:
+		return true
+	case PENDING:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestShortStatus.
+const (
+	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestShortStatus enum.
+func (e PullRequestShortStatus) Valid() bool {
+	switch e {
+	case PullRequestShortStatusMERGED:
+		return true
+	case PullRequestShortStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ReadinessResponseStatus.
+const (
+	ReadinessResponseStatusDown ReadinessResponseStatus = "down"
+	ReadinessResponseStatusUp   ReadinessResponseStatus = "up"
+)
+
+// Valid indicates whether the value is a known member of the ReadinessResponseStatus enum.
+func (e ReadinessResponseStatus) Valid() bool {
+	switch e {
+	case ReadinessResponseStatusDown:
+		return true
+	case ReadinessResponseStatusUp:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ReassignedReviewReason.
+const (
+	Deactivated ReassignedReviewReason = "deactivated"
+	LeftTeam    ReassignedReviewReason = "left_team"
+	Reassigned  ReassignedReviewReason = "reassigned"
+	UserDeleted ReassignedReviewReason = "user_deleted"
+)
+
+// Valid indicates whether the value is a known member of the ReassignedReviewReason enum.
+func (e ReassignedReviewReason) Valid() bool {
+	switch e {
+	case Deactivated:
+		return true
+	case LeftTeam:
+		return true
+	case Reassigned:
+		return true
+	case UserDeleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RoutingRuleAction.
+const (
+	AddTeamMember RoutingRuleAction = "add_team_member"
+	SetReviewers  RoutingRuleAction = "set_reviewers"
+)
+
+// Valid indicates whether the value is a known member of the RoutingRuleAction enum.
+func (e RoutingRuleAction) Valid() bool {
+	switch e {
+	case AddTeamMember:
+		return true
+	case SetReviewers:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrderQuery.
+const (
+	OrderQueryAsc  OrderQuery = "asc"
+	OrderQueryDesc OrderQuery = "desc"
+)
+
+// Valid indicates whether the value is a known member of the OrderQuery enum.
+func (e OrderQuery) Valid() bool {
+	switch e {
+	case OrderQueryAsc:
+		return true
+	case OrderQueryDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StatsGroupByQuery.
+const (
+	StatsGroupByQueryDay  StatsGroupByQuery = "day"
+	StatsGroupByQueryTeam StatsGroupByQuery = "team"
+	StatsGroupByQueryUser StatsGroupByQuery = "user"
+	StatsGroupByQueryWeek StatsGroupByQuery = "week"
+)
+
+// Valid indicates whether the value is a known member of the StatsGroupByQuery enum.
+func (e StatsGroupByQuery) Valid() bool {
+	switch e {
+	case StatsGroupByQueryDay:
+		return true
+	case StatsGroupByQueryTeam:
+		return true
+	case StatsGroupByQueryUser:
+		return true
+	case StatsGroupByQueryWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListPullRequestsParamsStatus.
+const (
+	ListPullRequestsParamsStatusMERGED ListPullRequestsParamsStatus = "MERGED"
+	ListPullRequestsParamsStatusOPEN   ListPullRequestsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the ListPullRequestsParamsStatus enum.
+func (e ListPullRequestsParamsStatus) Valid() bool {
+	switch e {
+	case ListPullRequestsParamsStatusMERGED:
+		return true
+	case ListPullRequestsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListPullRequestsParamsSort.
+const (
+	CreatedAt ListPullRequestsParamsSort = "created_at"
+	MergedAt  ListPullRequestsParamsSort = "merged_at"
+)
+
+// Valid indicates whether the value is a known member of the ListPullRequestsParamsSort enum.
+func (e ListPullRequestsParamsSort) Valid() bool {
+	switch e {
+	case CreatedAt:
+		return true
+	case MergedAt:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListPullRequestsParamsOrder.
+const (
+	ListPullRequestsParamsOrderAsc  ListPullRequestsParamsOrder = "asc"
+	ListPullRequestsParamsOrderDesc ListPullRequestsParamsOrder = "desc"
+)
+
+// Valid indicates whether the value is a known member of the ListPullRequestsParamsOrder enum.
+func (e ListPullRequestsParamsOrder) Valid() bool {
+	switch e {
+	case ListPullRequestsParamsOrderAsc:
+		return true
+	case ListPullRequestsParamsOrderDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetStatisticsParamsGroupBy.
+const (
+	GetStatisticsParamsGroupByDay  GetStatisticsParamsGroupBy = "day"
+	GetStatisticsParamsGroupByTeam GetStatisticsParamsGroupBy = "team"
+	GetStatisticsParamsGroupByUser GetStatisticsParamsGroupBy = "user"
+	GetStatisticsParamsGroupByWeek GetStatisticsParamsGroupBy = "week"
+)
+
+// Valid indicates whether the value is a known member of the GetStatisticsParamsGroupBy enum.
+func (e GetStatisticsParamsGroupBy) Valid() bool {
+	switch e {
+	case GetStatisticsParamsGroupByDay:
+		return true
+	case GetStatisticsParamsGroupByTeam:
+		return true
+	case GetStatisticsParamsGroupByUser:
+		return true
+	case GetStatisticsParamsGroupByWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetStatisticsParamsFormat.
+const (
+	GetStatisticsParamsFormatCsv         GetStatisticsParamsFormat = "csv"
+	GetStatisticsParamsFormatJson        GetStatisticsParamsFormat = "json"
+	GetStatisticsParamsFormatOpenmetrics GetStatisticsParamsFormat = "openmetrics"
+)
+
+// Valid indicates whether the value is a known member of the GetStatisticsParamsFormat enum.
+func (e GetStatisticsParamsFormat) Valid() bool {
+	switch e {
+	case GetStatisticsParamsFormatCsv:
+		return true
+	case GetStatisticsParamsFormatJson:
+		return true
+	case GetStatisticsParamsFormatOpenmetrics:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetStatisticsParamsTable.
+const (
+	Assignments GetStatisticsParamsTable = "assignments"
+	Teams       GetStatisticsParamsTable = "teams"
+)
+
+// Valid indicates whether the value is a known member of the GetStatisticsParamsTable enum.
+func (e GetStatisticsParamsTable) Valid() bool {
+	switch e {
+	case Assignments:
+		return true
+	case Teams:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportTeamsParamsFormat.
+const (
+	ExportTeamsParamsFormatCsv   ExportTeamsParamsFormat = "csv"
+	ExportTeamsParamsFormatJsonl ExportTeamsParamsFormat = "jsonl"
+)
+
+// Valid indicates whether the value is a known member of the ExportTeamsParamsFormat enum.
+func (e ExportTeamsParamsFormat) Valid() bool {
+	switch e {
+	case ExportTeamsParamsFormatCsv:
+		return true
+	case ExportTeamsParamsFormatJsonl:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportTeamsParamsFormat.
+const (
+	ImportTeamsParamsFormatCsv   ImportTeamsParamsFormat = "csv"
+	ImportTeamsParamsFormatJsonl ImportTeamsParamsFormat = "jsonl"
+)
+
+// Valid indicates whether the value is a known member of the ImportTeamsParamsFormat enum.
+func (e ImportTeamsParamsFormat) Valid() bool {
+	switch e {
+	case ImportTeamsParamsFormatCsv:
+		return true
+	case ImportTeamsParamsFormatJsonl:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUserReviewsParamsOrder.
+const (
+	GetUserReviewsParamsOrderAsc  GetUserReviewsParamsOrder = "asc"
+	GetUserReviewsParamsOrderDesc GetUserReviewsParamsOrder = "desc"
+)
+
+// Valid indicates whether the value is a known member of the GetUserReviewsParamsOrder enum.
+func (e GetUserReviewsParamsOrder) Valid() bool {
+	switch e {
+	case GetUserReviewsParamsOrderAsc:
+		return true
+	case GetUserReviewsParamsOrderDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// DeletedRoutingRule defines model for DeletedRoutingRule.
+type DeletedRoutingRule struct {
+	Id int `json:"id"`
+}
+
+// DeletedUser defines model for DeletedUser.
+type DeletedUser struct {
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+	UserId            string             `json:"user_id"`
+}
+
+// DependencyHealth defines model for DependencyHealth.
+type DependencyHealth struct {
+	Error   *string                `json:"error,omitempty"`
+	Status  DependencyHealthStatus `json:"status"`
+	Version *int                   `json:"version,omitempty"`
+}
+
+// DependencyHealthStatus defines model for DependencyHealth.Status.
+type DependencyHealthStatus string
+
+// ErrorCode defines model for ErrorCode.
+type ErrorCode string
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	Code ErrorCode `json:"code"`
+
+	// Details Затронутые объекты, например pull_request_id и old_reviewer_id
+	Details *map[string]interface{} `json:"details,omitempty"`
+	Message string                  `json:"message"`
+}
+
+// ErrorResponse Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// FairnessOutlier defines model for FairnessOutlier.
+type FairnessOutlier struct {
+	ActiveDays              float32             `json:"active_days"`
+	AssignmentCount         int                 `json:"assignment_count"`
+	ExpectedAssignmentCount float32             `json:"expected_assignment_count"`
+	Load                    FairnessOutlierLoad `json:"load"`
+	UserId                  string              `json:"user_id"`
+	Username                string              `json:"username"`
+}
+
+// FairnessOutlierLoad defines model for FairnessOutlier.Load.
+type FairnessOutlierLoad string
+
+// FairnessResponse defines model for FairnessResponse.
+type FairnessResponse struct {
+	Teams []TeamFairness `json:"teams"`
+}
+
+// GroupStats defines model for GroupStats.
+type GroupStats struct {
+	AssignmentCount int    `json:"assignment_count"`
+	Key             string `json:"key"`
+	WeightedLoad    int    `json:"weighted_load"`
+}
+
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	Status HealthResponseStatus `json:"status"`
+}
+
+// HealthResponseStatus defines model for HealthResponse.Status.
+type HealthResponseStatus string
+
+// ImportErrorResponse defines model for ImportErrorResponse.
+type ImportErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+
+	// Rows Ошибки по строкам файла, нумерация с 1
+	Rows *[]RowError `json:"rows,omitempty"`
+}
+
+// ImportResponse defines model for ImportResponse.
+type ImportResponse struct {
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+	Rows              int                `json:"rows"`
+	TeamsCreated      []string           `json:"teams_created"`
+	UsersCreated      int                `json:"users_created"`
+	UsersUpdated      int                `json:"users_updated"`
+}
+
+// LatencyResponse defines model for LatencyResponse.
+type LatencyResponse struct {
+	Throughput   []WeeklyThroughput `json:"throughput"`
+	TimeInReview []ReviewerLatency  `json:"time_in_review"`
+	TimeToMerge  []TeamLatency      `json:"time_to_merge"`
+}
+
+// Percentiles defines model for Percentiles.
+type Percentiles struct {
+	Count      int     `json:"count"`
+	P50Seconds float32 `json:"p50_seconds"`
+	P90Seconds float32 `json:"p90_seconds"`
+	P99Seconds float32 `json:"p99_seconds"`
+}
+
+// PrStats PR считаются по дню создания: окно from/to выбирает PR, созданные в нём, а merged_pr_count — сколько из них уже слито, когда бы это ни случилось. Слияния по дню слияния — в /stats/latency.
+type PrStats struct {
+	MergedPrCount int `json:"merged_pr_count"`
+	OpenPrCount   int `json:"open_pr_count"`
+	PrCount       int `json:"pr_count"`
+}
+
+// Problem RFC 7807, отдаётся при Accept application/problem+json
+type Problem struct {
+	Code     ErrorCode               `json:"code"`
+	Detail   string                  `json:"detail"`
+	Details  *map[string]interface{} `json:"details,omitempty"`
+	Instance *string                 `json:"instance,omitempty"`
+	Status   int                     `json:"status"`
+	Title    string                  `json:"title"`
+	Type     string                  `json:"type"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	Additions *int `json:"additions,omitempty"`
+
+	// AssignedReviewers user_id назначенных ревьюверов
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	Deletions         *int       `json:"deletions,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+	FilesChanged      *int       `json:"files_changed,omitempty"`
+	Labels            *[]string  `json:"labels,omitempty"`
+	MergedAt          *time.Time `json:"merged_at,omitempty"`
+
+	// ParentId PR, на котором стоит этот (стек)
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// PendingReviewers Места ревьюверов, которые ещё не удалось заполнить
+	PendingReviewers *int   `json:"pending_reviewers,omitempty"`
+	PullRequestId    string `json:"pull_request_id"`
+	PullRequestName  string `json:"pull_request_name"`
+
+	// Size Размер в строках, additions + deletions если не задан явно
+	Size   *int              `json:"size,omitempty"`
+	Status PullRequestStatus `json:"status"`
+	Url    *string           `json:"url,omitempty"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestEvent defines model for PullRequestEvent.
+type PullRequestEvent struct {
+	CreatedAt *time.Time `json:"created_at"`
+	Details   *string    `json:"details,omitempty"`
+	Type      string     `json:"type"`
+	UserId    *string    `json:"user_id,omitempty"`
+}
+
+// PullRequestHistoryResponse defines model for PullRequestHistoryResponse.
+type PullRequestHistoryResponse struct {
+	Events        []PullRequestEvent `json:"events"`
+	PullRequestId string             `json:"pull_request_id"`
+}
+
+// PullRequestListResponse defines model for PullRequestListResponse.
+type PullRequestListResponse struct {
+	NextCursor   *string       `json:"next_cursor,omitempty"`
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+// PullRequestResponse defines model for PullRequestResponse.
+type PullRequestResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AssignedAt      *time.Time                   `json:"assigned_at,omitempty"`
+	AuthorId        string                       `json:"author_id"`
+	PullRequestId   string                       `json:"pull_request_id"`
+	PullRequestName string                       `json:"pull_request_name"`
+	ReviewState     *PullRequestShortReviewState `json:"review_state,omitempty"`
+	Status          PullRequestShortStatus       `json:"status"`
+}
+
+// PullRequestShortReviewState defines model for PullRequestShort.ReviewState.
+type PullRequestShortReviewState string
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// PullRequestStackResponse defines model for PullRequestStackResponse.
+type PullRequestStackResponse struct {
+	PullRequestId string        `json:"pull_request_id"`
+	PullRequests  []PullRequest `json:"pull_requests"`
+}
+
+// ReadinessResponse defines model for ReadinessResponse.
+type ReadinessResponse struct {
+	Checks map[string]DependencyHealth `json:"checks"`
+	Status ReadinessResponseStatus     `json:"status"`
+}
+
+// ReadinessResponseStatus defines model for ReadinessResponse.Status.
+type ReadinessResponseStatus string
+
+// ReassignResponse defines model for ReassignResponse.
+type ReassignResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+// ReassignedReview defines model for ReassignedReview.
+type ReassignedReview struct {
+	OldReviewerId *string                 `json:"old_reviewer_id,omitempty"`
+	PullRequestId string                  `json:"pull_request_id"`
+	Reason        *ReassignedReviewReason `json:"reason,omitempty"`
+
+	// ReplacedBy Пусто, если замены не нашлось
+	ReplacedBy *string `json:"replaced_by,omitempty"`
+}
+
+// ReassignedReviewReason defines model for ReassignedReview.Reason.
+type ReassignedReviewReason string
+
+// ReviewerLatency defines model for ReviewerLatency.
+type ReviewerLatency struct {
+	Count      int     `json:"count"`
+	P50Seconds float32 `json:"p50_seconds"`
+	P90Seconds float32 `json:"p90_seconds"`
+	P99Seconds float32 `json:"p99_seconds"`
+	UserId     string  `json:"user_id"`
+	Username   string  `json:"username"`
+}
+
+// Roster defines model for Roster.
+type Roster struct {
+	Teams []RosterTeam `json:"teams"`
+}
+
+// RosterTeam defines model for RosterTeam.
+type RosterTeam struct {
+	Members  *[]TeamMember `json:"members,omitempty"`
+	TeamName string        `json:"team_name"`
+}
+
+// RoutingRule defines model for RoutingRule.
+type RoutingRule struct {
+	Action RoutingRuleAction `json:"action"`
+	Id     int               `json:"id"`
+	Label  string            `json:"label"`
+
+	// Reviewers Для set_reviewers
+	Reviewers *int `json:"reviewers,omitempty"`
+
+	// TargetTeamName Для add_team_member
+	TargetTeamName *string `json:"target_team_name,omitempty"`
+}
+
+// RoutingRuleAction defines model for RoutingRuleAction.
+type RoutingRuleAction string
+
+// RoutingRuleFields defines model for RoutingRuleFields.
+type RoutingRuleFields struct {
+	Action RoutingRuleAction `json:"action"`
+	Label  string            `json:"label"`
+
+	// Reviewers Для set_reviewers
+	Reviewers *int `json:"reviewers,omitempty"`
+
+	// TargetTeamName Для add_team_member
+	TargetTeamName *string `json:"target_team_name,omitempty"`
+}
+
+// RoutingRulesResponse defines model for RoutingRulesResponse.
+type RoutingRulesResponse struct {
+	Rules    *[]RoutingRule `json:"rules"`
+	TeamName string         `json:"team_name"`
+}
+
+// RowError defines model for RowError.
+type RowError struct {
+	Message string `json:"message"`
+	Row     int    `json:"row"`
+}
+
+// SizeTier defines model for SizeTier.
+type SizeTier struct {
+	// MaxLines Верхняя граница размера PR, у одного яруса может отсутствовать
+	MaxLines        *int `json:"max_lines,omitempty"`
+	Reviewers       int  `json:"reviewers"`
+	SeniorReviewers *int `json:"senior_reviewers,omitempty"`
+}
+
+// SizeTiersResponse defines model for SizeTiersResponse.
+type SizeTiersResponse struct {
+	TeamName string      `json:"team_name"`
+	Tiers    *[]SizeTier `json:"tiers"`
+}
+
+// StatsResponse defines model for StatsResponse.
+type StatsResponse struct {
+	// Groups Заполнен при group_by team, day или week
+	Groups *[]GroupStats `json:"groups,omitempty"`
+
+	// Pr PR считаются по дню создания: окно from/to выбирает PR, созданные в нём, а merged_pr_count — сколько из них уже слито, когда бы это ни случилось. Слияния по дню слияния — в /stats/latency.
+	Pr    PrStats       `json:"pr"`
+	Teams []TeamPrStats `json:"teams"`
+	Users []UserStats   `json:"users"`
+}
+
+// SyncChange defines model for SyncChange.
+type SyncChange struct {
+	// Action create_team, create_user, update_user, join_team, leave_team, deactivate_user
+	Action   string  `json:"action"`
+	TeamName *string `json:"team_name,omitempty"`
+	UserId   *string `json:"user_id,omitempty"`
+}
+
+// SyncResponse defines model for SyncResponse.
+type SyncResponse struct {
+	Changes           []SyncChange       `json:"changes"`
+	DryRun            bool               `json:"dry_run"`
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	AssignmentCount int     `json:"assignment_count"`
+	Gini            float32 `json:"gini"`
+
+	// MaxMinRatio null, если у кого-то из участников ноль назначений
+	MaxMinRatio      *float32          `json:"max_min_ratio"`
+	MeanPerActiveDay float32           `json:"mean_per_active_day"`
+	MemberCount      int               `json:"member_count"`
+	Outliers         []FairnessOutlier `json:"outliers"`
+	StdDev           float32           `json:"std_dev"`
+	TeamName         string            `json:"team_name"`
+}
+
+// TeamLatency defines model for TeamLatency.
+type TeamLatency struct {
+	Count      int     `json:"count"`
+	P50Seconds float32 `json:"p50_seconds"`
+	P90Seconds float32 `json:"p90_seconds"`
+	P99Seconds float32 `json:"p99_seconds"`
+	TeamName   string  `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// TeamPrStats defines model for TeamPrStats.
+type TeamPrStats struct {
+	MergedPrCount int    `json:"merged_pr_count"`
+	OpenPrCount   int    `json:"open_pr_count"`
+	PrCount       int    `json:"pr_count"`
+	TeamName      string `json:"team_name"`
+}
+
+// TeamResponse defines model for TeamResponse.
+type TeamResponse struct {
+	Team Team `json:"team"`
+}
+
+// User defines model for User.
+type User struct {
+	IsActive bool  `json:"is_active"`
+	IsSenior *bool `json:"is_senior,omitempty"`
+
+	// TeamName Первая команда пользователя, пустая если команд нет
+	TeamName  string    `json:"team_name"`
+	TeamNames *[]string `json:"team_names,omitempty"`
+	UserId    string    `json:"user_id"`
+	Username  string    `json:"username"`
+}
+
+// UserListResponse defines model for UserListResponse.
+type UserListResponse struct {
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+	Users  []User `json:"users"`
+}
+
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	User User `json:"user"`
+}
+
+// UserReviewsResponse defines model for UserReviewsResponse.
+type UserReviewsResponse struct {
+	NextCursor   *string            `json:"next_cursor,omitempty"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+// UserStats defines model for UserStats.
+type UserStats struct {
+	AssignedCount *int `json:"assigned_count,omitempty"`
+
+	// AssignmentCount Текущие ревью
+	AssignmentCount     int    `json:"assignment_count"`
+	CompletedCount      *int   `json:"completed_count,omitempty"`
+	OpenCount           *int   `json:"open_count,omitempty"`
+	ReassignedAwayCount *int   `json:"reassigned_away_count,omitempty"`
+	UserId              string `json:"user_id"`
+	Username            string `json:"username"`
+
+	// WeightedLoad Текущие ревью с весом по размеру PR
+	WeightedLoad int `json:"weighted_load"`
+}
+
+// WeeklyThroughput defines model for WeeklyThroughput.
+type WeeklyThroughput struct {
+	MergedPrCount int                `json:"merged_pr_count"`
+	Week          openapi_types.Date `json:"week"`
+}
+
+// CursorQuery defines model for CursorQuery.
+type CursorQuery = string
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery = int
+
+// OrderQuery defines model for OrderQuery.
+type OrderQuery string
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
+// StatsFromQuery defines model for StatsFromQuery.
+type StatsFromQuery = time.Time
+
+// StatsGroupByQuery defines model for StatsGroupByQuery.
+type StatsGroupByQuery string
+
+// StatsSortQuery defines model for StatsSortQuery.
+type StatsSortQuery = string
+
+// StatsTeamQuery defines model for StatsTeamQuery.
+type StatsTeamQuery = string
+
+// StatsToQuery defines model for StatsToQuery.
+type StatsToQuery = time.Time
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// BadRequestApplicationJSON Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type BadRequestApplicationJSON = ErrorResponse
+
+// BadRequestApplicationProblemPlusJSON RFC 7807, отдаётся при Accept application/problem+json
+type BadRequestApplicationProblemPlusJSON = Problem
+
+// ConflictApplicationJSON Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type ConflictApplicationJSON = ErrorResponse
+
+// ConflictApplicationProblemPlusJSON RFC 7807, отдаётся при Accept application/problem+json
+type ConflictApplicationProblemPlusJSON = Problem
+
+// IdempotencyConflict Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type IdempotencyConflict = ErrorResponse
+
+// NotFoundApplicationJSON Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type NotFoundApplicationJSON = ErrorResponse
+
+// NotFoundApplicationProblemPlusJSON RFC 7807, отдаётся при Accept application/problem+json
+type NotFoundApplicationProblemPlusJSON = Problem
+
+// PayloadTooLargeApplicationJSON Example: {"error":{"code":"NOT_FOUND","message":"resource not found"}}
+type PayloadTooLargeApplicationJSON = ErrorResponse
+
+// PayloadTooLargeApplicationProblemPlusJSON RFC 7807, отдаётся при Accept application/problem+json
+type PayloadTooLargeApplicationProblemPlusJSON = Problem
+
+// CreatePullRequestJSONBody defines parameters for CreatePullRequest.
+type CreatePullRequestJSONBody struct {
+	Additions        *int      `json:"additions,omitempty"`
+	AuthorId         string    `json:"author_id"`
+	Deletions        *int      `json:"deletions,omitempty"`
+	FilesChanged     *int      `json:"files_changed,omitempty"`
+	InheritReviewers *bool     `json:"inherit_reviewers,omitempty"`
+	Labels           *[]string `json:"labels,omitempty"`
+	ParentId         *string   `json:"parent_id,omitempty"`
+	PullRequestId    string    `json:"pull_request_id"`
+	PullRequestName  string    `json:"pull_request_name"`
+
+	// TeamName Обязателен, если автор состоит в нескольких командах
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// CreatePullRequestParams defines parameters for CreatePullRequest.
+type CreatePullRequestParams struct {
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetPullRequestParams defines parameters for GetPullRequest.
+type GetPullRequestParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// ListPullRequestsParams defines parameters for ListPullRequests.
+type ListPullRequestsParams struct {
+	Status      *ListPullRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId    *string                       `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerId  *string                       `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+	TeamName    *string                       `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Label       *string                       `form:"label,omitempty" json:"label,omitempty"`
+	CreatedFrom *time.Time                    `form:"created_from,omitempty" json:"created_from,omitempty"`
+	CreatedTo   *time.Time                    `form:"created_to,omitempty" json:"created_to,omitempty"`
+	MergedFrom  *time.Time                    `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+	MergedTo    *time.Time                    `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+	Sort        *ListPullRequestsParamsSort   `form:"sort,omitempty" json:"sort,omitempty"`
+	Order       *ListPullRequestsParamsOrder  `form:"order,omitempty" json:"order,omitempty"`
+	Limit       *LimitQuery                   `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущей страницы, выдан для той же сортировки
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListPullRequestsParamsStatus defines parameters for ListPullRequests.
+type ListPullRequestsParamsStatus string
+
+// ListPullRequestsParamsSort defines parameters for ListPullRequests.
+type ListPullRequestsParamsSort string
+
+// ListPullRequestsParamsOrder defines parameters for ListPullRequests.
+type ListPullRequestsParamsOrder string
+
+// MergePullRequestJSONBody defines parameters for MergePullRequest.
+type MergePullRequestJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// ReassignPullRequestJSONBody defines parameters for ReassignPullRequest.
+type ReassignPullRequestJSONBody struct {
+	OldReviewerId string `json:"old_reviewer_id"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// ReassignPullRequestParams defines parameters for ReassignPullRequest.
+type ReassignPullRequestParams struct {
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetPullRequestStackParams defines parameters for GetPullRequestStack.
+type GetPullRequestStackParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// UpdatePullRequestJSONBody defines parameters for UpdatePullRequest.
+type UpdatePullRequestJSONBody struct {
+	Description     *string   `json:"description,omitempty"`
+	Labels          *[]string `json:"labels,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName *string   `json:"pull_request_name,omitempty"`
+	Size            *int      `json:"size,omitempty"`
+	Url             *string   `json:"url,omitempty"`
+}
+
+// GetStatisticsParams defines parameters for GetStatistics.
+type GetStatisticsParams struct {
+	Sort *StatsSortQuery `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Team Только эта команда
+	Team    *StatsTeamQuery             `form:"team,omitempty" json:"team,omitempty"`
+	GroupBy *GetStatisticsParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// From Начало окна (RFC 3339), считается целыми сутками UTC
+	From *StatsFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (RFC 3339), неполные сутки округляются вверх
+	To *StatsToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// Format Без параметра формат выбирается по Accept
+	Format *GetStatisticsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Table Какую таблицу отдать в CSV
+	Table *GetStatisticsParamsTable `form:"table,omitempty" json:"table,omitempty"`
+}
+
+// GetStatisticsParamsGroupBy defines parameters for GetStatistics.
+type GetStatisticsParamsGroupBy string
+
+// GetStatisticsParamsFormat defines parameters for GetStatistics.
+type GetStatisticsParamsFormat string
+
+// GetStatisticsParamsTable defines parameters for GetStatistics.
+type GetStatisticsParamsTable string
+
+// GetFairnessParams defines parameters for GetFairness.
+type GetFairnessParams struct {
+	// Team Только эта команда
+	Team *StatsTeamQuery `form:"team,omitempty" json:"team,omitempty"`
+
+	// From Начало окна (RFC 3339), считается целыми сутками UTC
+	From *StatsFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (RFC 3339), неполные сутки округляются вверх
+	To *StatsToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetLatencyParams defines parameters for GetLatency.
+type GetLatencyParams struct {
+	// Team Только эта команда
+	Team *StatsTeamQuery `form:"team,omitempty" json:"team,omitempty"`
+
+	// From Начало окна (RFC 3339), считается целыми сутками UTC
+	From *StatsFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (RFC 3339), неполные сутки округляются вверх
+	To *StatsToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ExportTeamsParams defines parameters for ExportTeams.
+type ExportTeamsParams struct {
+	// TeamName Только эта команда
+	TeamName *string                  `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Format   *ExportTeamsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportTeamsParamsFormat defines parameters for ExportTeams.
+type ExportTeamsParamsFormat string
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// ImportTeamsParams defines parameters for ImportTeams.
+type ImportTeamsParams struct {
+	Format *ImportTeamsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ImportTeamsParamsFormat defines parameters for ImportTeams.
+type ImportTeamsParamsFormat string
+
+// GetRoutingRulesParams defines parameters for GetRoutingRules.
+type GetRoutingRulesParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// AddRoutingRuleJSONBody defines parameters for AddRoutingRule.
+type AddRoutingRuleJSONBody struct {
+	Action RoutingRuleAction `json:"action"`
+	Label  string            `json:"label"`
+
+	// Reviewers Для set_reviewers
+	Reviewers *int `json:"reviewers,omitempty"`
+
+	// TargetTeamName Для add_team_member
+	TargetTeamName *string `json:"target_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
+
+// DeleteRoutingRuleJSONBody defines parameters for DeleteRoutingRule.
+type DeleteRoutingRuleJSONBody struct {
+	Id int `json:"id"`
+}
+
+// UpdateRoutingRuleJSONBody defines parameters for UpdateRoutingRule.
+type UpdateRoutingRuleJSONBody struct {
+	Action RoutingRuleAction `json:"action"`
+	Id     int               `json:"id"`
+	Label  string            `json:"label"`
+
+	// Reviewers Для set_reviewers
+	Reviewers *int `json:"reviewers,omitempty"`
+
+	// TargetTeamName Для add_team_member
+	TargetTeamName *string `json:"target_team_name,omitempty"`
+}
+
+// SetSizeTiersJSONBody defines parameters for SetSizeTiers.
+type SetSizeTiersJSONBody struct {
+	TeamName string      `json:"team_name"`
+	Tiers    *[]SizeTier `json:"tiers,omitempty"`
+}
+
+// GetSizeTiersParams defines parameters for GetSizeTiers.
+type GetSizeTiersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// SyncTeamsParams defines parameters for SyncTeams.
+type SyncTeamsParams struct {
+	// DryRun Только посчитать изменения
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// MaxDeactivations Синхронизация (и dry_run) отклоняется с 409 TOO_MANY_DEACTIVATIONS, если деактивирует больше пользователей; 0 снимает ограничение
+	MaxDeactivations *int `form:"max_deactivations,omitempty" json:"max_deactivations,omitempty"`
+}
+
+// DeleteUserJSONBody defines parameters for DeleteUser.
+type DeleteUserJSONBody struct {
+	ReassignReviews *bool  `json:"reassign_reviews,omitempty"`
+	UserId          string `json:"user_id"`
+}
+
+// GetUserParams defines parameters for GetUser.
+type GetUserParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUserReviewsParams defines parameters for GetUserReviews.
+type GetUserReviewsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Status OPEN, MERGED или оба через запятую
+	Status *string                    `form:"status,omitempty" json:"status,omitempty"`
+	Order  *GetUserReviewsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit  *LimitQuery                `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущей страницы, выдан для той же сортировки
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetUserReviewsParamsOrder defines parameters for GetUserReviews.
+type GetUserReviewsParamsOrder string
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	TeamName *string     `form:"team_name,omitempty" json:"team_name,omitempty"`
+	IsActive *bool       `form:"is_active,omitempty" json:"is_active,omitempty"`
+	Limit    *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset   *int        `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SetUserIsActiveJSONBody defines parameters for SetUserIsActive.
+type SetUserIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+}
+
+// UpdateUserJSONBody defines parameters for UpdateUser.
+type UpdateUserJSONBody struct {
+	IsSenior *bool  `json:"is_senior,omitempty"`
+	UserId   string `json:"user_id"`
+
+	// Username Обязателен, если не передан is_senior
+	Username *string `json:"username,omitempty"`
+}
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody CreatePullRequestJSONBody
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody MergePullRequestJSONBody
+
+// ReassignPullRequestJSONRequestBody defines body for ReassignPullRequest for application/json ContentType.
+type ReassignPullRequestJSONRequestBody ReassignPullRequestJSONBody
+
+// UpdatePullRequestJSONRequestBody defines body for UpdatePullRequest for application/json ContentType.
+type UpdatePullRequestJSONRequestBody UpdatePullRequestJSONBody
+
+// AddTeamJSONRequestBody defines body for AddTeam for application/json ContentType.
+type AddTeamJSONRequestBody = Team
+
+// AddRoutingRuleJSONRequestBody defines body for AddRoutingRule for application/json ContentType.
+type AddRoutingRuleJSONRequestBody AddRoutingRuleJSONBody
+
+// DeleteRoutingRuleJSONRequestBody defines body for DeleteRoutingRule for application/json ContentType.
+type DeleteRoutingRuleJSONRequestBody DeleteRoutingRuleJSONBody
+
+// UpdateRoutingRuleJSONRequestBody defines body for UpdateRoutingRule for application/json ContentType.
+type UpdateRoutingRuleJSONRequestBody UpdateRoutingRuleJSONBody
+
+// SetSizeTiersJSONRequestBody defines body for SetSizeTiers for application/json ContentType.
+type SetSizeTiersJSONRequestBody SetSizeTiersJSONBody
+
+// SyncTeamsJSONRequestBody defines body for SyncTeams for application/json ContentType.
+type SyncTeamsJSONRequestBody = Roster
+
+// DeleteUserJSONRequestBody defines body for DeleteUser for application/json ContentType.
+type DeleteUserJSONRequestBody DeleteUserJSONBody
+
+// SetUserIsActiveJSONRequestBody defines body for SetUserIsActive for application/json ContentType.
+type SetUserIsActiveJSONRequestBody SetUserIsActiveJSONBody
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody UpdateUserJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// GetHealth То же, что /health/live (оставлен для совместимости)
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// GetLiveness Liveness — процесс отвечает, зависимости не проверяются
+	// (GET /health/live)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
+	// GetReadiness Readiness — Postgres доступен и миграции не отстают
+	// (GET /health/ready)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
+	// GetMetrics Метрики Prometheus
+	// (GET /metrics)
+	GetMetrics(w http.ResponseWriter, r *http.Request)
+	// CreatePullRequest Создать PR и назначить ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	CreatePullRequest(w http.ResponseWriter, r *http.Request, params CreatePullRequestParams)
+	// GetPullRequest Получить PR
+	// (GET /pullRequest/get)
+	GetPullRequest(w http.ResponseWriter, r *http.Request, params GetPullRequestParams)
+	// GetPullRequestHistory История событий PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams)
+	// ListPullRequests Список PR с фильтрами и курсорной пагинацией
+	// (GET /pullRequest/list)
+	ListPullRequests(w http.ResponseWriter, r *http.Request, params ListPullRequestsParams)
+	// MergePullRequest Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(w http.ResponseWriter, r *http.Request)
+	// ReassignPullRequest Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignPullRequest(w http.ResponseWriter, r *http.Request, params ReassignPullRequestParams)
+	// GetPullRequestStack Стек, в который входит PR, от корня вниз
+	// (GET /pullRequest/stack)
+	GetPullRequestStack(w http.ResponseWriter, r *http.Request, params GetPullRequestStackParams)
+	// UpdatePullRequest Изменить метаданные PR
+	// (POST /pullRequest/update)
+	UpdatePullRequest(w http.ResponseWriter, r *http.Request)
+	// GetStatistics Назначения ревьюверов и счётчики PR
+	// (GET /stats)
+	GetStatistics(w http.ResponseWriter, r *http.Request, params GetStatisticsParams)
+	// GetFairness Равномерность нагрузки внутри команд с учётом дней активности
+	// (GET /stats/fairness)
+	GetFairness(w http.ResponseWriter, r *http.Request, params GetFairnessParams)
+	// GetLatency Время до слияния, время в ревью и недельная пропускная способность
+	// (GET /stats/latency)
+	GetLatency(w http.ResponseWriter, r *http.Request, params GetLatencyParams)
+	// AddTeam Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(w http.ResponseWriter, r *http.Request)
+	// ExportTeams Выгрузить членство в командах в CSV или JSON Lines
+	// (GET /team/export)
+	ExportTeams(w http.ResponseWriter, r *http.Request, params ExportTeamsParams)
+	// GetTeam Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams)
+	// ImportTeams Импорт членства в командах из CSV или JSON Lines
+	// (POST /team/import)
+	ImportTeams(w http.ResponseWriter, r *http.Request, params ImportTeamsParams)
+	// GetRoutingRules Правила маршрутизации по меткам PR
+	// (GET /team/rules)
+	GetRoutingRules(w http.ResponseWriter, r *http.Request, params GetRoutingRulesParams)
+	// AddRoutingRule Добавить правило маршрутизации
+	// (POST /team/rules/add)
+	AddRoutingRule(w http.ResponseWriter, r *http.Request)
+	// DeleteRoutingRule Удалить правило маршрутизации
+	// (POST /team/rules/delete)
+	DeleteRoutingRule(w http.ResponseWriter, r *http.Request)
+	// UpdateRoutingRule Изменить правило маршрутизации
+	// (POST /team/rules/update)
+	UpdateRoutingRule(w http.ResponseWriter, r *http.Request)
+	// SetSizeTiers Заменить ярусы команды, пустой список возвращает 2 ревьювера на любой PR
+	// (POST /team/setSizeTiers)
+	SetSizeTiers(w http.ResponseWriter, r *http.Request)
+	// GetSizeTiers Ярусы числа ревьюверов по размеру PR
+	// (GET /team/sizeTiers)
+	GetSizeTiers(w http.ResponseWriter, r *http.Request, params GetSizeTiersParams)
+	// SyncTeams Привести команды к переданному составу (JSON или YAML)
+	// (POST /team/sync)
+	SyncTeams(w http.ResponseWriter, r *http.Request, params SyncTeamsParams)
+	// DeleteUser Удалить пользователя
+	// (POST /users/delete)
+	DeleteUser(w http.ResponseWriter, r *http.Request)
+	// GetUser Получить пользователя
+	// (GET /users/get)
+	GetUser(w http.ResponseWriter, r *http.Request, params GetUserParams)
+	// GetUserReviews Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams)
+	// ListUsers Список пользователей
+	// (GET /users/list)
+	ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams)
+	// SetUserIsActive Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserIsActive(w http.ResponseWriter, r *http.Request)
+	// UpdateUser Изменить имя или признак senior
+	// (POST /users/update)
+	UpdateUser(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// GetHealth То же, что /health/live (оставлен для совместимости)
+// (GET /health)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetLiveness Liveness — процесс отвечает, зависимости не проверяются
+// (GET /health/live)
+func (_ Unimplemented) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetReadiness Readiness — Postgres доступен и миграции не отстают
+// (GET /health/ready)
+func (_ Unimplemented) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetMetrics Метрики Prometheus
+// (GET /metrics)
+func (_ Unimplemented) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// CreatePullRequest Создать PR и назначить ревьюверов из команды автора
+// (POST /pullRequest/create)
+func (_ Unimplemented) CreatePullRequest(w http.ResponseWriter, r *http.Request, params CreatePullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetPullRequest Получить PR
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequest(w http.ResponseWriter, r *http.Request, params GetPullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetPullRequestHistory История событий PR
+// (GET /pullRequest/history)
+func (_ Unimplemented) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ListPullRequests Список PR с фильтрами и курсорной пагинацией
+// (GET /pullRequest/list)
+func (_ Unimplemented) ListPullRequests(w http.ResponseWriter, r *http.Request, params ListPullRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// MergePullRequest Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ReassignPullRequest Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) ReassignPullRequest(w http.ResponseWriter, r *http.Request, params ReassignPullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetPullRequestStack Стек, в который входит PR, от корня вниз
+// (GET /pullRequest/stack)
+func (_ Unimplemented) GetPullRequestStack(w http.ResponseWriter, r *http.Request, params GetPullRequestStackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// UpdatePullRequest Изменить метаданные PR
+// (POST /pullRequest/update)
+func (_ Unimplemented) UpdatePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetStatistics Назначения ревьюверов и счётчики PR
+// (GET /stats)
+func (_ Unimplemented) GetStatistics(w http.ResponseWriter, r *http.Request, params GetStatisticsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetFairness Равномерность нагрузки внутри команд с учётом дней активности
+// (GET /stats/fairness)
+func (_ Unimplemented) GetFairness(w http.ResponseWriter, r *http.Request, params GetFairnessParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetLatency Время до слияния, время в ревью и недельная пропускная способность
+// (GET /stats/latency)
+func (_ Unimplemented) GetLatency(w http.ResponseWriter, r *http.Request, params GetLatencyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// AddTeam Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) AddTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ExportTeams Выгрузить членство в командах в CSV или JSON Lines
+// (GET /team/export)
+func (_ Unimplemented) ExportTeams(w http.ResponseWriter, r *http.Request, params ExportTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetTeam Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ImportTeams Импорт членства в командах из CSV или JSON Lines
+// (POST /team/import)
+func (_ Unimplemented) ImportTeams(w http.ResponseWriter, r *http.Request, params ImportTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetRoutingRules Правила маршрутизации по меткам PR
+// (GET /team/rules)
+func (_ Unimplemented) GetRoutingRules(w http.ResponseWriter, r *http.Request, params GetRoutingRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// AddRoutingRule Добавить правило маршрутизации
+// (POST /team/rules/add)
+func (_ Unimplemented) AddRoutingRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// DeleteRoutingRule Удалить правило маршрутизации
+// (POST /team/rules/delete)
+func (_ Unimplemented) DeleteRoutingRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// UpdateRoutingRule Изменить правило маршрутизации
+// (POST /team/rules/update)
+func (_ Unimplemented) UpdateRoutingRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// SetSizeTiers Заменить ярусы команды, пустой список возвращает 2 ревьювера на любой PR
+// (POST /team/setSizeTiers)
+func (_ Unimplemented) SetSizeTiers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetSizeTiers Ярусы числа ревьюверов по размеру PR
+// (GET /team/sizeTiers)
+func (_ Unimplemented) GetSizeTiers(w http.ResponseWriter, r *http.Request, params GetSizeTiersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// SyncTeams Привести команды к переданному составу (JSON или YAML)
+// (POST /team/sync)
+func (_ Unimplemented) SyncTeams(w http.ResponseWriter, r *http.Request, params SyncTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// DeleteUser Удалить пользователя
+// (POST /users/delete)
+func (_ Unimplemented) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetUser Получить пользователя
+// (GET /users/get)
+func (_ Unimplemented) GetUser(w http.ResponseWriter, r *http.Request, params GetUserParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetUserReviews Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ListUsers Список пользователей
+// (GET /users/list)
+func (_ Unimplemented) ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// SetUserIsActive Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// UpdateUser Изменить имя или признак senior
+// (POST /users/update)
+func (_ Unimplemented) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLiveness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMetrics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPullRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "author_id", r.URL.Query(), &params.AuthorId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "author_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "reviewer_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "label", r.URL.Query(), &params.Label, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "label"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "created_from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "created_to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "merged_from", r.URL.Query(), &params.MergedFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "merged_from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "merged_to", r.URL.Query(), &params.MergedTo, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "merged_to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "sort", r.URL.Query(), &params.Sort, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sort"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPullRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReassignPullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignPullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestStack operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestStack(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestStackParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestStack(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatistics operation middleware
+func (siw *ServerInterfaceWrapper) GetStatistics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticsParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "sort", r.URL.Query(), &params.Sort, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sort"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team", r.URL.Query(), &params.Team, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "group_by", r.URL.Query(), &params.GroupBy, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_by"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "table" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "table", r.URL.Query(), &params.Table, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "table"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "table", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatistics(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetFairness(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFairnessParams
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team", r.URL.Query(), &params.Team, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFairness(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLatency operation middleware
+func (siw *ServerInterfaceWrapper) GetLatency(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatencyParams
+
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team", r.URL.Query(), &params.Team, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLatency(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTeam operation middleware
+func (siw *ServerInterfaceWrapper) AddTeam(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportTeams operation middleware
+func (siw *ServerInterfaceWrapper) ExportTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTeamsParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTeams operation middleware
+func (siw *ServerInterfaceWrapper) ImportTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTeamsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRoutingRules operation middleware
+func (siw *ServerInterfaceWrapper) GetRoutingRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRoutingRulesParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoutingRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddRoutingRule operation middleware
+func (siw *ServerInterfaceWrapper) AddRoutingRule(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddRoutingRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRoutingRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRoutingRule(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRoutingRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRoutingRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRoutingRule(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRoutingRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSizeTiers operation middleware
+func (siw *ServerInterfaceWrapper) SetSizeTiers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSizeTiers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSizeTiers operation middleware
+func (siw *ServerInterfaceWrapper) GetSizeTiers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSizeTiersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSizeTiers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SyncTeams operation middleware
+func (siw *ServerInterfaceWrapper) SyncTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SyncTeamsParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dry_run"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "max_deactivations" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "max_deactivations", r.URL.Query(), &params.MaxDeactivations, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "max_deactivations"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_deactivations", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "user_id", r.URL.Query(), &params.UserId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetUserReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserReviewsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "user_id", r.URL.Query(), &params.UserId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "is_active", r.URL.Query(), &params.IsActive, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "is_active"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "is_active", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", r.URL.Query(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserIsActive operation middleware
+func (siw *ServerInterfaceWrapper) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/live", wrapper.GetLiveness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/ready", wrapper.GetReadiness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/metrics", wrapper.GetMetrics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.AddTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/sync", wrapper.SyncTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/import", wrapper.ImportTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/export", wrapper.ExportTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/sizeTiers", wrapper.GetSizeTiers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setSizeTiers", wrapper.SetSizeTiers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/rules", wrapper.GetRoutingRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rules/add", wrapper.AddRoutingRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rules/update", wrapper.UpdateRoutingRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rules/delete", wrapper.DeleteRoutingRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.SetUserIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUserReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/get", wrapper.GetUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/list", wrapper.ListUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/update", wrapper.UpdateUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/delete", wrapper.DeleteUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.CreatePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.MergePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.ReassignPullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/update", wrapper.UpdatePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/list", wrapper.ListPullRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/stack", wrapper.GetPullRequestStack)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats", wrapper.GetStatistics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/latency", wrapper.GetLatency)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/fairness", wrapper.GetFairness)
+	})
+
+	return r
+}
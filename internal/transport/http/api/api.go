@@ -0,0 +1,5 @@
+// Package api holds the server interface and parameter types generated from
+// docs/openapi.yml. The handlers implement ServerInterface.
+package api
+
+//go:generate go tool oapi-codegen --config=oapi-codegen.yml ../../../../docs/openapi.yml
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/render"
+)
+
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 body. Code and Details carry the same values as
+// ErrorResponse does.
+type Problem struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail"`
+	Instance string         `json:"instance,omitempty"`
+	Code     string         `json:"code"`
+	Details  map[string]any `json:"details,omitempty"`
+}
+
+// WantsProblem reports whether the Accept header names problem+json.
+func WantsProblem(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		if strings.EqualFold(strings.TrimSpace(mediaType), ProblemContentType) {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteError answers with resp and status, as a problem when the client
+// asked for application/problem+json.
+func WriteError(w http.ResponseWriter, r *http.Request, status int, resp ErrorResponse) {
+	if !WantsProblem(r.Header.Get("Accept")) {
+		render.Status(r, status)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   resp.Error.Message,
+		Instance: r.URL.Path,
+		Code:     resp.Error.Code,
+		Details:  resp.Error.Details,
+	})
+}
@@ -1,12 +1,5 @@
 package dto
 
-import (
-	"fmt"
-	"strings"
-
-	"github.com/go-playground/validator/v10"
-)
-
 const (
 	ErrInternalErr     = "INTERNAL_ERROR"
 	ErrValidationErr   = "VALIDATION_ERROR"
@@ -17,6 +10,21 @@ const (
 	ErrCodePRMerged    = "PR_MERGED"
 	ErrCodeNotAssigned = "NOT_ASSIGNED"
 	ErrCodeNoCandidate = "NO_CANDIDATE"
+
+	ErrCodeHasOpenReviews  = "HAS_OPEN_REVIEWS"
+	ErrCodeUserIsAuthor    = "USER_IS_AUTHOR"
+	ErrCodeTeamRequired    = "TEAM_REQUIRED"
+	ErrCodeNotTeamMember   = "NOT_TEAM_MEMBER"
+	ErrCodeRuleExists      = "RULE_EXISTS"
+	ErrCodeParentNotMerged = "PARENT_NOT_MERGED"
+
+	ErrCodeTooManyDeactivations = "TOO_MANY_DEACTIVATIONS"
+	ErrCodeEmptyDirectory       = "EMPTY_DIRECTORY"
+
+	ErrCodeIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+	ErrCodeRequestInProgress    = "REQUEST_IN_PROGRESS"
+
+	ErrCodeRequestTooLarge = "REQUEST_TOO_LARGE"
 )
 
 type TeamResponse struct {
@@ -27,13 +35,77 @@ type UserResponse struct {
 	User UserSchema `json:"user"`
 }
 
+type UserListResponse struct {
+	Users  []UserSchema `json:"users"`
+	Limit  int          `json:"limit"`
+	Offset int          `json:"offset"`
+}
+
+type DeleteUserResponse struct {
+	UserID            string             `json:"user_id"`
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+}
+
+type ReassignedReview struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id,omitempty"`
+	ReplacedBy    string `json:"replaced_by,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+type SyncResponse struct {
+	DryRun            bool               `json:"dry_run"`
+	Changes           []SyncChange       `json:"changes"`
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+}
+
+type ImportResponse struct {
+	Rows              int                `json:"rows"`
+	TeamsCreated      []string           `json:"teams_created"`
+	UsersCreated      int                `json:"users_created"`
+	UsersUpdated      int                `json:"users_updated"`
+	ReassignedReviews []ReassignedReview `json:"reassigned_reviews"`
+}
+
+type SyncChange struct {
+	Action   string `json:"action"`
+	TeamName string `json:"team_name,omitempty"`
+	UserID   string `json:"user_id,omitempty"`
+}
+
 type PrResponse struct {
 	PullRequest PullRequestSchema `json:"pr"`
 }
 
+type SizeTiersResponse struct {
+	TeamName string     `json:"team_name"`
+	Tiers    []SizeTier `json:"tiers"`
+}
+
+type RoutingRulesResponse struct {
+	TeamName string        `json:"team_name"`
+	Rules    []RoutingRule `json:"rules"`
+}
+
+type StackResponse struct {
+	PullRequestID string              `json:"pull_request_id"`
+	PullRequests  []PullRequestSchema `json:"pull_requests"`
+}
+
+type HistoryResponse struct {
+	PullRequestID string             `json:"pull_request_id"`
+	Events        []PullRequestEvent `json:"events"`
+}
+
+type PrListResponse struct {
+	PullRequests []PullRequestSchema `json:"pull_requests"`
+	NextCursor   string              `json:"next_cursor,omitempty"`
+}
+
 type GetReviewResponse struct {
 	UserID       string             `json:"user_id"`
 	PullRequests []PullRequestShort `json:"pull_requests"`
+	NextCursor   string             `json:"next_cursor,omitempty"`
 }
 
 type ReassignResponse struct {
@@ -46,19 +118,41 @@ type ErrorResponse struct {
 }
 
 type ErrorDetail struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code    string         `json:"code"`
+	Message string         `json:"message"`
+	Details map[string]any `json:"details,omitempty"`
 }
 
+// StatsResponse lists users when grouped by user and groups otherwise.
 type StatsResponse struct {
-	Pr   PrStats     `json:"pr"`
-	User []UserStats `json:"users"`
+	Pr     PrStats       `json:"pr"`
+	User   []UserStats   `json:"users"`
+	Groups []GroupStats  `json:"groups,omitempty"`
+	Teams  []TeamPrStats `json:"teams"`
 }
 
+type GroupStats struct {
+	Key             string `json:"key"`
+	AssignmentCount int    `json:"assignment_count"`
+	WeightedLoad    int    `json:"weighted_load"`
+}
+
+type TeamPrStats struct {
+	TeamName string `json:"team_name"`
+	PrStats
+}
+
+// UserStats counts current reviews in assignment_count and weighted_load, the
+// rest covers every assignment made in the window by how it went.
 type UserStats struct {
 	UserID          string `json:"user_id"`
 	Username        string `json:"username"`
 	AssignmentCount int    `json:"assignment_count"`
+	WeightedLoad    int    `json:"weighted_load"`
+	Assigned        int    `json:"assigned_count"`
+	Reassigned      int    `json:"reassigned_away_count"`
+	Completed       int    `json:"completed_count"`
+	Open            int    `json:"open_count"`
 }
 
 type PrStats struct {
@@ -67,6 +161,80 @@ type PrStats struct {
 	MergedPrs int `json:"merged_pr_count"`
 }
 
+// LatencyResponse covers PRs merged in the requested window. Percentiles
+// are in seconds.
+type LatencyResponse struct {
+	TimeToMerge  []TeamLatency      `json:"time_to_merge"`
+	TimeInReview []ReviewerLatency  `json:"time_in_review"`
+	Throughput   []WeeklyThroughput `json:"throughput"`
+}
+
+type Percentiles struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50_seconds"`
+	P90   float64 `json:"p90_seconds"`
+	P99   float64 `json:"p99_seconds"`
+}
+
+type TeamLatency struct {
+	TeamName string `json:"team_name"`
+	Percentiles
+}
+
+type ReviewerLatency struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Percentiles
+}
+
+type WeeklyThroughput struct {
+	Week   string `json:"week"`
+	Merged int    `json:"merged_pr_count"`
+}
+
+// FairnessResponse measures assignments per active day, members without
+// active days in the window are left out.
+type FairnessResponse struct {
+	Teams []TeamFairness `json:"teams"`
+}
+
+type TeamFairness struct {
+	TeamName        string            `json:"team_name"`
+	Members         int               `json:"member_count"`
+	AssignmentCount int               `json:"assignment_count"`
+	MeanRate        float64           `json:"mean_per_active_day"`
+	Gini            float64           `json:"gini"`
+	MaxMinRatio     *float64          `json:"max_min_ratio"`
+	StdDev          float64           `json:"std_dev"`
+	Outliers        []FairnessOutlier `json:"outliers"`
+}
+
+type FairnessOutlier struct {
+	UserID          string  `json:"user_id"`
+	Username        string  `json:"username"`
+	Load            string  `json:"load"`
+	AssignmentCount int     `json:"assignment_count"`
+	Expected        float64 `json:"expected_assignment_count"`
+	ActiveDays      float64 `json:"active_days"`
+}
+
+const (
+	HealthUp   = "up"
+	HealthDown = "down"
+)
+
+// ReadinessResponse is up only when every check is up.
+type ReadinessResponse struct {
+	Status string                      `json:"status"`
+	Checks map[string]DependencyHealth `json:"checks"`
+}
+
+type DependencyHealth struct {
+	Status  string `json:"status"`
+	Version uint   `json:"version,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
 func Error(code string, msg string) ErrorResponse {
 	return ErrorResponse{
 		Error: ErrorDetail{
@@ -84,27 +252,3 @@ func InternalError() ErrorResponse {
 		},
 	}
 }
-
-func ValidationError(errs validator.ValidationErrors) ErrorResponse {
-	var errMsgs []string
-	for _, err := range errs {
-		switch err.ActualTag() {
-		case "required":
-			errMsgs = append(errMsgs, fmt.Sprintf("field '%s' is required", err.Field()))
-		case "max":
-			errMsgs = append(
-				errMsgs,
-				fmt.Sprintf("field '%s' must be no more than %s characters", err.Field(), err.Param()),
-			)
-		default:
-			errMsgs = append(errMsgs, fmt.Sprintf("field '%s' is not valid", err.Field()))
-		}
-	}
-
-	return ErrorResponse{
-		Error: ErrorDetail{
-			Code:    ErrValidationErr,
-			Message: strings.Join(errMsgs, ", "),
-		},
-	}
-}
@@ -0,0 +1,12 @@
+package dto
+
+import "sigs.k8s.io/yaml"
+
+// ParseRoster accepts both YAML and JSON roster documents.
+func ParseRoster(data []byte) (*Roster, error) {
+	var roster Roster
+	if err := yaml.Unmarshal(data, &roster); err != nil {
+		return nil, err
+	}
+	return &roster, nil
+}
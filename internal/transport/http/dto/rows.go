@@ -0,0 +1,276 @@
+package dto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+var teamRowHeader = []string{"team_name", "user_id", "username", "is_active"}
+
+// TeamRow is one line of a team import or export file.
+type TeamRow struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type RowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+type ImportErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+	Rows  []RowError  `json:"rows"`
+}
+
+func ImportError(rowErrs []RowError) ImportErrorResponse {
+	return ImportErrorResponse{
+		Error: ErrorDetail{
+			Code:    ErrValidationErr,
+			Message: fmt.Sprintf("%d invalid rows", len(rowErrs)),
+		},
+		Rows: rowErrs,
+	}
+}
+
+// FormatFromContentType maps a request Content-Type to an import format.
+func FormatFromContentType(contentType string) string {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	switch strings.TrimSpace(strings.ToLower(mediaType)) {
+	case "text/csv":
+		return FormatCSV
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return FormatJSONL
+	}
+	return ""
+}
+
+// ParseTeamRowsCSV reads rows in the team_name,user_id,username,is_active order.
+// The header line is optional. Every broken row is reported, the error is only
+// returned when the stream itself cannot be read.
+func ParseTeamRowsCSV(r io.Reader) ([]TeamRow, []RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var (
+		rows    []TeamRow
+		rowErrs []RowError
+		line    int
+	)
+	v := newRowValidator()
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rowErrs = append(rowErrs, RowError{Row: line, Message: parseErr.Err.Error()})
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if line == 1 && isHeader(record) {
+			continue
+		}
+
+		if len(record) != len(teamRowHeader) {
+			rowErrs = append(rowErrs, RowError{
+				Row:     line,
+				Message: fmt.Sprintf("expected %d columns, got %d", len(teamRowHeader), len(record)),
+			})
+			continue
+		}
+
+		isActive, err := strconv.ParseBool(strings.TrimSpace(record[3]))
+		if err != nil {
+			rowErrs = append(rowErrs, RowError{Row: line, Message: "is_active must be 'true' or 'false'"})
+			continue
+		}
+
+		row := TeamRow{
+			TeamName: csvValue(strings.TrimSpace(record[0])),
+			UserID:   csvValue(strings.TrimSpace(record[1])),
+			Username: csvValue(strings.TrimSpace(record[2])),
+			IsActive: isActive,
+		}
+		if msg := v.check(row); msg != "" {
+			rowErrs = append(rowErrs, RowError{Row: line, Message: msg})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrs, nil
+}
+
+// ParseTeamRowsJSONL reads one JSON object per line, empty lines are skipped.
+func ParseTeamRowsJSONL(r io.Reader) ([]TeamRow, []RowError, error) {
+	scanner := bufio.NewScanner(r)
+
+	var (
+		rows    []TeamRow
+		rowErrs []RowError
+		line    int
+	)
+	v := newRowValidator()
+
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var raw struct {
+			TeamName string `json:"team_name"`
+			UserID   string `json:"user_id"`
+			Username string `json:"username"`
+			IsActive *bool  `json:"is_active"`
+		}
+		dec := json.NewDecoder(bytes.NewReader(text))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&raw); err != nil {
+			rowErrs = append(rowErrs, RowError{Row: line, Message: "invalid JSON object"})
+			continue
+		}
+		if raw.IsActive == nil {
+			rowErrs = append(rowErrs, RowError{Row: line, Message: "field 'is_active' is required"})
+			continue
+		}
+
+		row := TeamRow{
+			TeamName: strings.TrimSpace(raw.TeamName),
+			UserID:   strings.TrimSpace(raw.UserID),
+			Username: strings.TrimSpace(raw.Username),
+			IsActive: *raw.IsActive,
+		}
+		if msg := v.check(row); msg != "" {
+			rowErrs = append(rowErrs, RowError{Row: line, Message: msg})
+			continue
+		}
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return rows, rowErrs, nil
+}
+
+// WriteTeamRowsCSV quotes formula-like cells like the stats export does,
+// ParseTeamRowsCSV takes the quote off again so an export imports back as is.
+func WriteTeamRowsCSV(w io.Writer, rows []TeamRow) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(teamRowHeader); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := []string{csvCell(row.TeamName), csvCell(row.UserID), csvCell(row.Username), strconv.FormatBool(row.IsActive)}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func WriteTeamRowsJSONL(w io.Writer, rows []TeamRow) error {
+	enc := json.NewEncoder(w)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// csvCell quotes a value a spreadsheet would run as a formula, names come
+// from users and the exports are meant to be opened in one.
+func csvCell(v string) string {
+	if isFormula(v) {
+		return "'" + v
+	}
+	return v
+}
+
+// csvValue undoes csvCell.
+func csvValue(v string) string {
+	if rest, ok := strings.CutPrefix(v, "'"); ok && isFormula(rest) {
+		return rest
+	}
+	return v
+}
+
+func isFormula(v string) bool {
+	return v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0]))
+}
+
+func isHeader(record []string) bool {
+	if len(record) != len(teamRowHeader) {
+		return false
+	}
+	for i, col := range teamRowHeader {
+		if strings.TrimSpace(strings.ToLower(record[i])) != col {
+			return false
+		}
+	}
+	return true
+}
+
+// rowValidator checks a row on its own and against the rows seen before it.
+type rowValidator struct {
+	users   map[string]TeamRow
+	members map[[2]string]struct{}
+}
+
+func newRowValidator() *rowValidator {
+	return &rowValidator{
+		users:   make(map[string]TeamRow),
+		members: make(map[[2]string]struct{}),
+	}
+}
+
+func (v *rowValidator) check(row TeamRow) string {
+	switch {
+	case row.TeamName == "":
+		return "field 'team_name' is required"
+	case row.UserID == "":
+		return "field 'user_id' is required"
+	case row.Username == "":
+		return "field 'username' is required"
+	}
+
+	key := [2]string{row.TeamName, row.UserID}
+	if _, ok := v.members[key]; ok {
+		return fmt.Sprintf("user '%s' is listed twice in team '%s'", row.UserID, row.TeamName)
+	}
+
+	if prev, ok := v.users[row.UserID]; ok && (prev.Username != row.Username || prev.IsActive != row.IsActive) {
+		return fmt.Sprintf("user '%s' has different username or is_active in another row", row.UserID)
+	}
+
+	v.members[key] = struct{}{}
+	v.users[row.UserID] = row
+	return ""
+}
@@ -0,0 +1,32 @@
+package dto_test
+
+import (
+	"bytes"
+	"testing"
+
+	"railgorail/avito/internal/transport/http/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamRowsCSV_FormulasRoundTrip(t *testing.T) {
+	rows := []dto.TeamRow{
+		{TeamName: "=HYPERLINK(\"x\")", UserID: "u1", Username: "+Alice", IsActive: true},
+		{TeamName: "backend", UserID: "-u2", Username: "@Bob", IsActive: false},
+		{TeamName: "backend", UserID: "u3", Username: "'quoted", IsActive: true},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, dto.WriteTeamRowsCSV(&buf, rows))
+
+	assert.Equal(t, "team_name,user_id,username,is_active\n"+
+		"\"'=HYPERLINK(\"\"x\"\")\",u1,'+Alice,true\n"+
+		"backend,'-u2,'@Bob,false\n"+
+		"backend,u3,'quoted,true\n", buf.String())
+
+	parsed, rowErrs, err := dto.ParseTeamRowsCSV(&buf)
+	require.NoError(t, err)
+	assert.Empty(t, rowErrs)
+	assert.Equal(t, rows, parsed)
+}
@@ -2,11 +2,15 @@ package dto
 
 import "time"
 
+// UserSchema keeps team_name for older clients: it is the team the user joined
+// first, the full membership is in team_names.
 type UserSchema struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	UserID    string   `json:"user_id"`
+	Username  string   `json:"username"`
+	TeamName  string   `json:"team_name"`
+	TeamNames []string `json:"team_names"`
+	IsActive  bool     `json:"is_active"`
+	IsSenior  bool     `json:"is_senior"`
 }
 
 type TeamSchema struct {
@@ -14,24 +18,74 @@ type TeamSchema struct {
 	Members  []TeamMember `json:"members"`
 }
 
+// Roster is the full desired state of teams and their members, usually kept
+// in a repository file and applied with /team/sync or cmd/teamsync.
+type Roster struct {
+	Teams []RosterTeam `json:"teams" validate:"required,min=1,dive"`
+}
+
+type RosterTeam struct {
+	TeamName string       `json:"team_name" validate:"required"`
+	Members  []TeamMember `json:"members"`
+}
+
 type TeamMember struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
 	IsActive bool   `json:"is_active"`
 }
 
+// SizeTier applies to PRs of up to max_lines changed lines, a tier without
+// max_lines takes everything bigger than the other tiers.
+type SizeTier struct {
+	MaxLines        *int `json:"max_lines,omitempty"`
+	Reviewers       int  `json:"reviewers"`
+	SeniorReviewers int  `json:"senior_reviewers"`
+}
+
+// RoutingRule fires on the team's PRs labelled with label. add_team_member
+// needs target_team_name, set_reviewers needs reviewers.
+type RoutingRule struct {
+	ID             int    `json:"id"`
+	Label          string `json:"label"`
+	Action         string `json:"action"`
+	TargetTeamName string `json:"target_team_name,omitempty"`
+	Reviewers      *int   `json:"reviewers,omitempty"`
+}
+
 type PullRequestSchema struct {
 	ID                string     `json:"pull_request_id"`
 	Name              string     `json:"pull_request_name"`
 	AuthorID          string     `json:"author_id"`
 	Status            string     `json:"status"`
 	AssignedReviewers []string   `json:"assigned_reviewers"`
+	PendingReviewers  int        `json:"pending_reviewers"`
+	ParentID          *string    `json:"parent_id,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	Labels            []string   `json:"labels"`
+	URL               string     `json:"url,omitempty"`
+	Size              int        `json:"size"`
+	Additions         *int       `json:"additions,omitempty"`
+	Deletions         *int       `json:"deletions,omitempty"`
+	FilesChanged      *int       `json:"files_changed,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
 	MergedAt          *time.Time `json:"merged_at,omitempty"`
 }
 
+type PullRequestEvent struct {
+	Type      string     `json:"type"`
+	UserID    *string    `json:"user_id,omitempty"`
+	Details   string     `json:"details,omitempty"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// PullRequestShort is a PR in a reviewer's list, ReviewState is PENDING while
+// the PR is open and DONE once it is merged.
 type PullRequestShort struct {
-	ID       string `json:"pull_request_id"`
-	Name     string `json:"pull_request_name"`
-	AuthorID string `json:"author_id"`
-	Status   string `json:"status"`
+	ID          string     `json:"pull_request_id"`
+	Name        string     `json:"pull_request_name"`
+	AuthorID    string     `json:"author_id"`
+	Status      string     `json:"status"`
+	AssignedAt  *time.Time `json:"assigned_at,omitempty"`
+	ReviewState string     `json:"review_state,omitempty"`
 }
@@ -0,0 +1,154 @@
+package dto
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatJSON        = "json"
+	FormatOpenMetrics = "openmetrics"
+
+	OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+	// StatsTableAssignments is the users table, or the groups table when the
+	// stats are grouped by something else
+	StatsTableAssignments = "assignments"
+	StatsTableTeams       = "teams"
+)
+
+// FormatFromAccept picks the first export format the Accept header names,
+// JSON when it names none.
+func FormatFromAccept(accept string) string {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		switch strings.TrimSpace(strings.ToLower(mediaType)) {
+		case "text/csv":
+			return FormatCSV
+		case "application/openmetrics-text":
+			return FormatOpenMetrics
+		case "application/json":
+			return FormatJSON
+		}
+	}
+	return FormatJSON
+}
+
+// WriteStatsCSV writes one table of the stats, a CSV file holds one header.
+func WriteStatsCSV(w io.Writer, stats *StatsResponse, table string) error {
+	writer := csv.NewWriter(w)
+
+	var records [][]string
+	switch {
+	case table == StatsTableTeams:
+		records = append(records, []string{"team_name", "pr_count", "open_pr_count", "merged_pr_count"})
+		for _, t := range stats.Teams {
+			records = append(records, []string{
+				csvCell(t.TeamName), strconv.Itoa(t.PrCount), strconv.Itoa(t.OpenPrs), strconv.Itoa(t.MergedPrs),
+			})
+		}
+	case stats.Groups != nil:
+		records = append(records, []string{"key", "assignment_count", "weighted_load"})
+		for _, g := range stats.Groups {
+			records = append(records, []string{csvCell(g.Key), strconv.Itoa(g.AssignmentCount), strconv.Itoa(g.WeightedLoad)})
+		}
+	default:
+		records = append(records, []string{
+			"user_id", "username", "assignment_count", "weighted_load",
+			"assigned_count", "reassigned_away_count", "completed_count", "open_count",
+		})
+		for _, u := range stats.User {
+			records = append(records, []string{
+				csvCell(u.UserID), csvCell(u.Username), strconv.Itoa(u.AssignmentCount), strconv.Itoa(u.WeightedLoad),
+				strconv.Itoa(u.Assigned), strconv.Itoa(u.Reassigned), strconv.Itoa(u.Completed), strconv.Itoa(u.Open),
+			})
+		}
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// WriteStatsOpenMetrics renders the stats as gauges for a scraper. groupBy
+// labels the grouped assignments.
+func WriteStatsOpenMetrics(w io.Writer, stats *StatsResponse, groupBy string) error {
+	m := &metricsWriter{w: w}
+
+	m.family("reviewer_assignments", "Review assignments per reviewer.")
+	for _, u := range stats.User {
+		m.sample("reviewer_assignments", u.AssignmentCount, "user_id", u.UserID, "username", u.Username)
+	}
+	m.family("reviewer_weighted_load", "Review assignments per reviewer weighted by PR size.")
+	for _, u := range stats.User {
+		m.sample("reviewer_weighted_load", u.WeightedLoad, "user_id", u.UserID, "username", u.Username)
+	}
+
+	m.family("reviewer_assignment_outcomes", "Review assignments per reviewer by how they went.")
+	for _, u := range stats.User {
+		m.sample("reviewer_assignment_outcomes", u.Assigned, "user_id", u.UserID, "username", u.Username, "outcome", "assigned")
+		m.sample("reviewer_assignment_outcomes", u.Reassigned, "user_id", u.UserID, "username", u.Username, "outcome", "reassigned_away")
+		m.sample("reviewer_assignment_outcomes", u.Completed, "user_id", u.UserID, "username", u.Username, "outcome", "completed")
+		m.sample("reviewer_assignment_outcomes", u.Open, "user_id", u.UserID, "username", u.Username, "outcome", "open")
+	}
+
+	if stats.Groups != nil {
+		m.family("grouped_assignments", "Review assignments per group.")
+		for _, g := range stats.Groups {
+			m.sample("grouped_assignments", g.AssignmentCount, "group_by", groupBy, "key", g.Key)
+		}
+		m.family("grouped_weighted_load", "Review assignments per group weighted by PR size.")
+		for _, g := range stats.Groups {
+			m.sample("grouped_weighted_load", g.WeightedLoad, "group_by", groupBy, "key", g.Key)
+		}
+	}
+
+	m.family("pull_requests", "Pull requests by status.")
+	m.sample("pull_requests", stats.Pr.OpenPrs, "status", "open")
+	m.sample("pull_requests", stats.Pr.MergedPrs, "status", "merged")
+
+	m.family("team_pull_requests", "Pull requests of a team by status.")
+	for _, t := range stats.Teams {
+		m.sample("team_pull_requests", t.OpenPrs, "team", t.TeamName, "status", "open")
+		m.sample("team_pull_requests", t.MergedPrs, "team", t.TeamName, "status", "merged")
+	}
+
+	m.printf("# EOF\n")
+	return m.err
+}
+
+// metricsWriter keeps the first write error, the rest of the writes are skipped.
+type metricsWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (m *metricsWriter) printf(format string, args ...any) {
+	if m.err != nil {
+		return
+	}
+	_, m.err = fmt.Fprintf(m.w, format, args...)
+}
+
+func (m *metricsWriter) family(name, help string) {
+	m.printf("# TYPE %s gauge\n# HELP %s %s\n", name, name, help)
+}
+
+// sample takes label names and values in turns.
+func (m *metricsWriter) sample(name string, value int, labels ...string) {
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[i], escapeLabel(labels[i+1])))
+	}
+	m.printf("%s{%s} %d\n", name, strings.Join(pairs, ","), value)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(v string) string {
+	return labelEscaper.Replace(v)
+}
@@ -0,0 +1,136 @@
+package dto_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"railgorail/avito/internal/transport/http/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteStatsCSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats *dto.StatsResponse
+		table string
+		want  string
+	}{
+		{
+			name: "assignments",
+			stats: &dto.StatsResponse{User: []dto.UserStats{
+				{UserID: "u1", Username: "Alice", AssignmentCount: 3, WeightedLoad: 5, Assigned: 1, Reassigned: 1, Completed: 1},
+			}},
+			table: dto.StatsTableAssignments,
+			want: "user_id,username,assignment_count,weighted_load,assigned_count,reassigned_away_count,completed_count,open_count\n" +
+				"u1,Alice,3,5,1,1,1,0\n",
+		},
+		{
+			name: "groups",
+			stats: &dto.StatsResponse{
+				User:   []dto.UserStats{{UserID: "u1", Username: "Alice"}},
+				Groups: []dto.GroupStats{{Key: "backend", AssignmentCount: 4, WeightedLoad: 6}},
+			},
+			table: dto.StatsTableAssignments,
+			want:  "key,assignment_count,weighted_load\nbackend,4,6\n",
+		},
+		{
+			name: "teams",
+			stats: &dto.StatsResponse{Teams: []dto.TeamPrStats{
+				{TeamName: "backend", PrStats: dto.PrStats{PrCount: 3, OpenPrs: 1, MergedPrs: 2}},
+			}},
+			table: dto.StatsTableTeams,
+			want:  "team_name,pr_count,open_pr_count,merged_pr_count\nbackend,3,1,2\n",
+		},
+		{
+			name: "formulas are quoted",
+			stats: &dto.StatsResponse{User: []dto.UserStats{
+				{UserID: "=1+1", Username: "+Alice"},
+				{UserID: "-u2", Username: "@Bob"},
+				{UserID: "u3", Username: "Carol=1"},
+			}},
+			table: dto.StatsTableAssignments,
+			want: "user_id,username,assignment_count,weighted_load,assigned_count,reassigned_away_count,completed_count,open_count\n" +
+				"'=1+1,'+Alice,0,0,0,0,0,0\n" +
+				"'-u2,'@Bob,0,0,0,0,0,0\n" +
+				"u3,Carol=1,0,0,0,0,0,0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, dto.WriteStatsCSV(&buf, tt.stats, tt.table))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestWriteStatsOpenMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    *dto.StatsResponse
+		contains []string
+	}{
+		{
+			name: "labels are escaped",
+			stats: &dto.StatsResponse{User: []dto.UserStats{
+				{UserID: "u1", Username: "Al \"the\" \\ice\nB", AssignmentCount: 2},
+			}},
+			contains: []string{`reviewer_assignments{user_id="u1",username="Al \"the\" \\ice\nB"} 2` + "\n"},
+		},
+		{
+			name: "groups are labelled",
+			stats: &dto.StatsResponse{
+				Groups: []dto.GroupStats{{Key: "backend", AssignmentCount: 4}},
+			},
+			contains: []string{`grouped_assignments{group_by="team",key="backend"} 4` + "\n"},
+		},
+		{
+			name:  "families without samples",
+			stats: &dto.StatsResponse{},
+			contains: []string{
+				"# TYPE pull_requests gauge\n",
+				`pull_requests{status="open"} 0` + "\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, dto.WriteStatsOpenMetrics(&buf, tt.stats, "team"))
+
+			out := buf.String()
+			for _, want := range tt.contains {
+				assert.Contains(t, out, want)
+			}
+			assert.True(t, strings.HasSuffix(out, "\n# EOF\n"), "the exposition has to end with # EOF")
+			assert.Equal(t, 1, strings.Count(out, "# EOF"))
+		})
+	}
+}
+
+func TestFormatFromAccept(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{accept: "", want: dto.FormatJSON},
+		{accept: "*/*", want: dto.FormatJSON},
+		{accept: "text/csv", want: dto.FormatCSV},
+		{accept: "Text/CSV; charset=utf-8", want: dto.FormatCSV},
+		{accept: "application/openmetrics-text; version=1.0.0", want: dto.FormatOpenMetrics},
+		{accept: "text/html, application/openmetrics-text;q=0.9, text/csv", want: dto.FormatOpenMetrics},
+		{accept: "application/json, text/csv", want: dto.FormatJSON},
+		{accept: "text/plain", want: dto.FormatJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			assert.Equal(t, tt.want, dto.FormatFromAccept(tt.accept))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+// MaxUploadBytes caps file-like uploads such as rosters and team imports.
+const MaxUploadBytes = 10 << 20
+
+// LimitBody makes reads past limit fail with *http.MaxBytesError.
+func LimitBody(w http.ResponseWriter, r *http.Request, limit int64) {
+	r.Body = http.MaxBytesReader(w, r.Body, limit)
+}
+
+// RenderBodyError answers a failed body read with 413 when the body went
+// over the limit and with 400 otherwise.
+func RenderBodyError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		log.Info("request body is too large", slog.Int64("limit", tooLarge.Limit))
+		dto.WriteError(w, r, http.StatusRequestEntityTooLarge, dto.Error(dto.ErrCodeRequestTooLarge,
+			fmt.Sprintf("request body exceeds %d bytes", tooLarge.Limit)))
+		return
+	}
+
+	log.Error("failed to read request body", sl.Err(err))
+	dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
+}
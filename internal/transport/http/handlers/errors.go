@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/service"
+	"railgorail/avito/internal/transport/http/dto"
+)
+
+// RenderError answers with the domain error in err. Anything else is
+// logged with msg and answered with a bare 500, its text never reaches
+// the client.
+func RenderError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error, msg string) {
+	e := service.AsError(err)
+	if e == nil {
+		log.Error(msg, sl.Err(err))
+		dto.WriteError(w, r, http.StatusInternalServerError, dto.InternalError())
+		return
+	}
+
+	log.Info("request failed", slog.String("code", e.Code), sl.Err(err))
+	dto.WriteError(w, r, e.Status, dto.ErrorResponse{
+		Error: dto.ErrorDetail{
+			Code:    e.Code,
+			Message: e.Message,
+			Details: e.Details,
+		},
+	})
+}
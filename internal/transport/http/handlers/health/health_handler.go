@@ -0,0 +1,55 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"railgorail/avito/internal/transport/http/dto"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+)
+
+type healthService interface {
+	Ready(ctx context.Context) *dto.ReadinessResponse
+}
+
+type HealthHandler struct {
+	log     *slog.Logger
+	service healthService
+}
+
+func NewHealthHandler(log *slog.Logger, s healthService) *HealthHandler {
+	return &HealthHandler{
+		log:     log,
+		service: s,
+	}
+}
+
+// GetLiveness only tells the process serves HTTP, it must not fail because a
+// dependency is down or the pod gets restarted for nothing.
+func (h *HealthHandler) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	render.JSON(w, r, map[string]string{"status": dto.HealthUp})
+}
+
+// GetHealth is the old name of the liveness probe.
+func (h *HealthHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	h.GetLiveness(w, r)
+}
+
+// GetReadiness answers 503 with the failed checks when the service should
+// get no traffic.
+func (h *HealthHandler) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.health.GetReadiness"
+
+	resp := h.service.Ready(r.Context())
+	if resp.Status != dto.HealthUp {
+		h.log.Warn("not ready",
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+			slog.Any("checks", resp.Checks),
+		)
+		render.Status(r, http.StatusServiceUnavailable)
+	}
+	render.JSON(w, r, resp)
+}
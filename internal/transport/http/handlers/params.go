@@ -0,0 +1,11 @@
+package handlers
+
+// Value returns what p points to, or the zero value when the optional
+// parameter was not sent.
+func Value[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
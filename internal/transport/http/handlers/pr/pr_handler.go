@@ -2,25 +2,35 @@ package pr
 
 import (
 	"context"
-	"errors"
 	"log/slog"
 	"net/http"
 
+	"railgorail/avito/internal/entity"
 	"railgorail/avito/internal/lib/sl"
-	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/transport/http/api"
 	"railgorail/avito/internal/transport/http/dto"
+	"railgorail/avito/internal/transport/http/handlers"
 
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
 )
 
 type prService interface {
-	Create(ctx context.Context, prID, prName, authorId string) (*dto.PullRequestSchema, error)
+	Create(ctx context.Context, prID, prName, authorId, teamName string, size entity.PullRequestSize, labels []string, parentID string, inheritReviewers bool) (*dto.PullRequestSchema, error)
 	Merge(ctx context.Context, prID string) (*dto.PullRequestSchema, error)
 	Reassign(ctx context.Context, prID, oldRev string) (*dto.ReassignResponse, error)
+	Get(ctx context.Context, prID string) (*dto.PullRequestSchema, error)
+	Stack(ctx context.Context, prID string) (*dto.StackResponse, error)
+	History(ctx context.Context, prID string) (*dto.HistoryResponse, error)
+	List(ctx context.Context, filter entity.PullRequestFilter) (*dto.PrListResponse, error)
+	Update(ctx context.Context, prID string, upd entity.PullRequestUpdate) (*dto.PullRequestSchema, error)
 }
 
+const (
+	defaultListLimit = 50
+	maxListLimit     = 100
+)
+
 type PrHandler struct {
 	log     *slog.Logger
 	service prService
@@ -33,14 +43,8 @@ func NewPrHandler(log *slog.Logger, s prService) *PrHandler {
 	}
 }
 
-type CreateRequest struct {
-	PrID     string `json:"pull_request_id"   validate:"required"`
-	PrName   string `json:"pull_request_name" validate:"required,min=5"`
-	AuthorId string `json:"author_id"         validate:"required"`
-}
-
-func (h *PrHandler) Create(w http.ResponseWriter, r *http.Request) {
-	const op = "handlers.pr.Create"
+func (h *PrHandler) CreatePullRequest(w http.ResponseWriter, r *http.Request, _ api.CreatePullRequestParams) {
+	const op = "handlers.pr.CreatePullRequest"
 	log := h.log.With(
 		slog.String("op", op),
 		slog.String("request_id", middleware.GetReqID(r.Context())),
@@ -48,57 +52,86 @@ func (h *PrHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	var input CreateRequest
+	var input api.CreatePullRequestJSONRequestBody
 	if err := render.DecodeJSON(r.Body, &input); err != nil {
 		log.Error("failed to decode request body", sl.Err(err))
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.Error(dto.ErrBadRequest, "bad request"))
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
 		return
 	}
 
-	if err := validator.New().Struct(input); err != nil {
-		validateError := err.(validator.ValidationErrors)
+	// parent_id stacks the PR on another one, its reviewers are inherited
+	// unless inherit_reviewers is false
+	resp, err := h.service.Create(ctx, input.PullRequestId, input.PullRequestName, input.AuthorId, handlers.Value(input.TeamName), entity.PullRequestSize{
+		Additions:    input.Additions,
+		Deletions:    input.Deletions,
+		FilesChanged: input.FilesChanged,
+	}, handlers.Value(input.Labels), handlers.Value(input.ParentId), input.InheritReviewers == nil || *input.InheritReviewers)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while creating pr")
+		return
+	}
 
-		log.Error("invalid request", sl.Err(err))
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, dto.PrResponse{
+		PullRequest: *resp,
+	})
+}
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.ValidationError(validateError))
+func (h *PrHandler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.pr.MergePullRequest"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	var input api.MergePullRequestJSONRequestBody
+	if err := render.DecodeJSON(r.Body, &input); err != nil {
+		log.Error("failed to decode request body", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
 		return
 	}
 
-	resp, err := h.service.Create(ctx, input.PrID, input.PrName, input.AuthorId)
+	resp, err := h.service.Merge(ctx, input.PullRequestId)
 	if err != nil {
-		if errors.Is(err, repo.ErrPRExists) {
-			log.Info("pr already exists", sl.Err(err))
-			render.Status(r, http.StatusConflict)
-			render.JSON(w, r, dto.Error(dto.ErrCodePRExists, err.Error()))
-			return
-		}
-		if errors.Is(err, repo.ErrNotFound) {
-			log.Info("resource not found", sl.Err(err))
-			render.Status(r, http.StatusNotFound)
-			render.JSON(w, r, dto.Error(dto.ErrCodeNotFound, err.Error()))
-			return
-		}
-		log.Error("error while creating pr", sl.Err(err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, dto.InternalError())
+		handlers.RenderError(w, r, log, err, "error while merging pr")
 		return
 	}
 
-	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, dto.PrResponse{
-		PullRequest: *resp,
-	})
+	render.JSON(w, r, dto.PrResponse{PullRequest: *resp})
 }
 
-type MergeRequest struct {
-	PrID string `json:"pull_request_id" validate:"required"`
+func (h *PrHandler) ReassignPullRequest(w http.ResponseWriter, r *http.Request, _ api.ReassignPullRequestParams) {
+	const op = "handlers.pr.ReassignPullRequest"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	var input api.ReassignPullRequestJSONRequestBody
+	if err := render.DecodeJSON(r.Body, &input); err != nil {
+		log.Error("failed to decode request body", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
+		return
+	}
+
+	resp, err := h.service.Reassign(ctx, input.PullRequestId, input.OldReviewerId)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while reassigning pr")
+		return
+	}
+
+	render.JSON(w, r, resp)
 }
 
-func (h *PrHandler) Merge(w http.ResponseWriter, r *http.Request) {
-	const op = "handlers.pr.Merge"
+func (h *PrHandler) UpdatePullRequest(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.pr.UpdatePullRequest"
 	log := h.log.With(
 		slog.String("op", op),
 		slog.String("request_id", middleware.GetReqID(r.Context())),
@@ -106,49 +139,81 @@ func (h *PrHandler) Merge(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	var input MergeRequest
+	var input api.UpdatePullRequestJSONRequestBody
 	if err := render.DecodeJSON(r.Body, &input); err != nil {
 		log.Error("failed to decode request body", sl.Err(err))
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.Error(dto.ErrBadRequest, "bad request"))
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
 		return
 	}
 
-	if err := validator.New().Struct(input); err != nil {
-		validateError := err.(validator.ValidationErrors)
+	// only the fields present in the body change, labels: [] clears the labels
+	resp, err := h.service.Update(ctx, input.PullRequestId, entity.PullRequestUpdate{
+		Title:       input.PullRequestName,
+		Description: input.Description,
+		Labels:      handlers.Value(input.Labels),
+		URL:         input.Url,
+		Size:        input.Size,
+	})
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while updating pr")
+		return
+	}
 
-		log.Error("invalid request", sl.Err(err))
+	render.JSON(w, r, dto.PrResponse{PullRequest: *resp})
+}
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.ValidationError(validateError))
+func (h *PrHandler) GetPullRequest(w http.ResponseWriter, r *http.Request, params api.GetPullRequestParams) {
+	const op = "handlers.pr.GetPullRequest"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	prID := params.PullRequestId
+	if prID == "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "pull_request_id is required"))
 		return
 	}
 
-	resp, err := h.service.Merge(ctx, input.PrID)
+	resp, err := h.service.Get(ctx, prID)
 	if err != nil {
-		if errors.Is(err, repo.ErrNotFound) {
-			log.Info("pr not found", sl.Err(err))
-			render.Status(r, http.StatusNotFound)
-			render.JSON(w, r, dto.Error(dto.ErrCodeNotFound, err.Error()))
-			return
-		}
-		log.Error("error while merging pr", sl.Err(err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, dto.InternalError())
+		handlers.RenderError(w, r, log, err, "error while retrieving pr")
 		return
 	}
 
 	render.JSON(w, r, dto.PrResponse{PullRequest: *resp})
 }
 
-type ReassignRequest struct {
-	PrID          string `json:"pull_request_id" validate:"required"`
-	OldReviewerID string `json:"old_reviewer_id" validate:"required"`
+// Stack returns the whole stack of the PR with statuses, root first.
+func (h *PrHandler) GetPullRequestStack(w http.ResponseWriter, r *http.Request, params api.GetPullRequestStackParams) {
+	const op = "handlers.pr.GetPullRequestStack"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	prID := params.PullRequestId
+	if prID == "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "pull_request_id is required"))
+		return
+	}
+
+	resp, err := h.service.Stack(ctx, prID)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while retrieving pr stack")
+		return
+	}
+
+	render.JSON(w, r, resp)
 }
 
-func (h *PrHandler) Reassign(w http.ResponseWriter, r *http.Request) {
-	const op = "handlers.pr.Merge"
+func (h *PrHandler) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params api.GetPullRequestHistoryParams) {
+	const op = "handlers.pr.GetPullRequestHistory"
 	log := h.log.With(
 		slog.String("op", op),
 		slog.String("request_id", middleware.GetReqID(r.Context())),
@@ -156,55 +221,103 @@ func (h *PrHandler) Reassign(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	var input ReassignRequest
-	if err := render.DecodeJSON(r.Body, &input); err != nil {
-		log.Error("failed to decode request body", sl.Err(err))
+	prID := params.PullRequestId
+	if prID == "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "pull_request_id is required"))
+		return
+	}
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.Error(dto.ErrBadRequest, "bad request"))
+	resp, err := h.service.History(ctx, prID)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while retrieving pr history")
 		return
 	}
 
-	if err := validator.New().Struct(input); err != nil {
-		validateError := err.(validator.ValidationErrors)
+	render.JSON(w, r, resp)
+}
 
-		log.Error("invalid request", sl.Err(err))
+// List accepts status, author_id, reviewer_id, team_name, label, created_from,
+// created_to, merged_from, merged_to (RFC 3339), sort (created_at or
+// merged_at), order (asc or desc), limit and cursor.
+func (h *PrHandler) ListPullRequests(w http.ResponseWriter, r *http.Request, params api.ListPullRequestsParams) {
+	const op = "handlers.pr.ListPullRequests"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.ValidationError(validateError))
+	ctx := r.Context()
+
+	filter, msg := parseListFilter(params)
+	if msg != "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, msg))
 		return
 	}
 
-	resp, err := h.service.Reassign(ctx, input.PrID, input.OldReviewerID)
+	resp, err := h.service.List(ctx, filter)
 	if err != nil {
-		switch {
-		case errors.Is(err, repo.ErrNotFound):
-			log.Info("resource not found", sl.Err(err))
-			render.Status(r, http.StatusNotFound)
-			render.JSON(w, r, dto.Error(dto.ErrCodeNotFound, err.Error()))
-
-		case errors.Is(err, repo.ErrNoCandidate):
-			log.Info("no candidate", sl.Err(err))
-			render.Status(r, http.StatusConflict)
-			render.JSON(w, r, dto.Error(dto.ErrCodeNoCandidate, err.Error()))
-
-		case errors.Is(err, repo.ErrPRMerged):
-			log.Info("no candidate", sl.Err(err))
-			render.Status(r, http.StatusConflict)
-			render.JSON(w, r, dto.Error(dto.ErrCodePRMerged, err.Error()))
-
-		case errors.Is(err, repo.ErrNotAssigned):
-			log.Info("no candidate", sl.Err(err))
-			render.Status(r, http.StatusConflict)
-			render.JSON(w, r, dto.Error(dto.ErrCodeNotAssigned, err.Error()))
-
-		default:
-			log.Error("error while reassigning pr", sl.Err(err))
-			render.Status(r, http.StatusInternalServerError)
-			render.JSON(w, r, dto.InternalError())
-		}
+		handlers.RenderError(w, r, log, err, "error while listing prs")
 		return
 	}
 
 	render.JSON(w, r, resp)
 }
+
+// parseListFilter returns a message for the client when a parameter is invalid.
+func parseListFilter(params api.ListPullRequestsParams) (entity.PullRequestFilter, string) {
+	filter := entity.PullRequestFilter{
+		Status:      string(handlers.Value(params.Status)),
+		AuthorID:    handlers.Value(params.AuthorId),
+		ReviewerID:  handlers.Value(params.ReviewerId),
+		TeamName:    handlers.Value(params.TeamName),
+		Label:       handlers.Value(params.Label),
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		MergedFrom:  params.MergedFrom,
+		MergedTo:    params.MergedTo,
+		SortBy:      entity.PullRequestSortCreatedAt,
+		Limit:       defaultListLimit,
+	}
+
+	switch filter.Status {
+	case "", "OPEN", "MERGED":
+	default:
+		return filter, "status must be OPEN or MERGED"
+	}
+
+	switch v := string(handlers.Value(params.Sort)); v {
+	case "", entity.PullRequestSortCreatedAt:
+	case entity.PullRequestSortMergedAt:
+		filter.SortBy = v
+	default:
+		return filter, "sort must be created_at or merged_at"
+	}
+
+	switch handlers.Value(params.Order) {
+	case "", api.ListPullRequestsParamsOrderAsc:
+	case api.ListPullRequestsParamsOrderDesc:
+		filter.Desc = true
+	default:
+		return filter, "order must be asc or desc"
+	}
+
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > maxListLimit {
+			return filter, "limit must be between 1 and 100"
+		}
+		filter.Limit = *params.Limit
+	}
+
+	if v := handlers.Value(params.Cursor); v != "" {
+		cursor, err := dto.DecodeCursor(v)
+		if err != nil {
+			return filter, err.Error()
+		}
+		if cursor.Sort != filter.SortBy || cursor.Desc != filter.Desc {
+			return filter, "cursor was issued for a different sort or order"
+		}
+		filter.After = &entity.PullRequestCursor{Value: cursor.Value, ID: cursor.ID}
+	}
+
+	return filter, ""
+}
@@ -4,16 +4,22 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"railgorail/avito/internal/entity"
 	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/transport/http/api"
 	"railgorail/avito/internal/transport/http/dto"
+	"railgorail/avito/internal/transport/http/handlers"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
 )
 
 type statsService interface {
-	GetStatistics(ctx context.Context, sort string) (*dto.StatsResponse, error)
+	GetStatistics(ctx context.Context, filter entity.StatsFilter) (*dto.StatsResponse, error)
+	GetLatency(ctx context.Context, filter entity.StatsFilter) (*dto.LatencyResponse, error)
+	GetFairness(ctx context.Context, filter entity.StatsFilter) (*dto.FairnessResponse, error)
 }
 
 type StatsHandler struct {
@@ -28,7 +34,11 @@ func NewStatsHandler(log *slog.Logger, s statsService) *StatsHandler {
 	}
 }
 
-func (h *StatsHandler) GetStatistics(w http.ResponseWriter, r *http.Request) {
+// GetStatistics accepts sort (asc or desc), team, from and to (RFC 3339)
+// and group_by (user, team, day or week). The format comes from the format
+// parameter (json, csv or openmetrics) or the Accept header, a CSV file holds
+// the table given by table (assignments or teams).
+func (h *StatsHandler) GetStatistics(w http.ResponseWriter, r *http.Request, params api.GetStatisticsParams) {
 	const op = "handlers.stats.GetStatistics"
 	log := h.log.With(
 		slog.String("op", op),
@@ -37,22 +47,141 @@ func (h *StatsHandler) GetStatistics(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	sort := r.URL.Query().Get("sort")
-	sort = strings.ToLower(sort)
-	if sort == "" {
-		sort = "desc"
-	} else if sort != "desc" && sort != "asc" {
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.Error(dto.ErrBadRequest, "sort must be 'desc' or 'asc'. can be omitted: 'desc' by default"))
+	filter, msg := parseStatsFilter(
+		handlers.Value(params.Sort),
+		handlers.Value(params.Team),
+		string(handlers.Value(params.GroupBy)),
+		params.From, params.To,
+	)
+	if msg != "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, msg))
+		return
+	}
+
+	format := string(handlers.Value(params.Format))
+	if format == "" {
+		format = dto.FormatFromAccept(r.Header.Get("Accept"))
+	}
+	if format != dto.FormatJSON && format != dto.FormatCSV && format != dto.FormatOpenMetrics {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "format must be 'json', 'csv' or 'openmetrics'"))
 		return
 	}
 
-	resp, err := h.service.GetStatistics(ctx, sort)
+	table := string(handlers.Value(params.Table))
+	if table == "" {
+		table = dto.StatsTableAssignments
+	}
+	if table != dto.StatsTableAssignments && table != dto.StatsTableTeams {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "table must be 'assignments' or 'teams'"))
+		return
+	}
+
+	resp, err := h.service.GetStatistics(ctx, filter)
 	if err != nil {
-		log.Error("error while retrieving statistics", sl.Err(err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, dto.InternalError())
+		handlers.RenderError(w, r, log, err, "error while retrieving statistics")
+		return
+	}
+
+	switch format {
+	case dto.FormatCSV:
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="stats-`+table+`.csv"`)
+		err = dto.WriteStatsCSV(w, resp, table)
+	case dto.FormatOpenMetrics:
+		w.Header().Set("Content-Type", dto.OpenMetricsContentType)
+		err = dto.WriteStatsOpenMetrics(w, resp, filter.GroupBy)
+	default:
+		render.JSON(w, r, resp)
+	}
+	if err != nil {
+		log.Error("failed to write stats", sl.Err(err))
+		return
 	}
-	log.Error("stats retrieved")
+
+	log.Info("stats retrieved", slog.String("format", format))
+}
+
+// GetLatency accepts team, from and to (RFC 3339), the window applies to
+// the merge time.
+func (h *StatsHandler) GetLatency(w http.ResponseWriter, r *http.Request, params api.GetLatencyParams) {
+	const op = "handlers.stats.GetLatency"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	filter, msg := parseStatsFilter("", handlers.Value(params.Team), "", params.From, params.To)
+	if msg != "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, msg))
+		return
+	}
+
+	resp, err := h.service.GetLatency(ctx, filter)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while retrieving latency")
+		return
+	}
+	log.Info("latency retrieved")
+	render.JSON(w, r, resp)
+}
+
+// GetFairness accepts team, from and to (RFC 3339), the window applies to
+// the assignment time.
+func (h *StatsHandler) GetFairness(w http.ResponseWriter, r *http.Request, params api.GetFairnessParams) {
+	const op = "handlers.stats.GetFairness"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	filter, msg := parseStatsFilter("", handlers.Value(params.Team), "", params.From, params.To)
+	if msg != "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, msg))
+		return
+	}
+
+	resp, err := h.service.GetFairness(ctx, filter)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while retrieving fairness")
+		return
+	}
+	log.Info("fairness retrieved")
 	render.JSON(w, r, resp)
 }
+
+// parseStatsFilter returns a message for the client when a parameter is invalid.
+func parseStatsFilter(sort, team, groupBy string, from, to *time.Time) (entity.StatsFilter, string) {
+	filter := entity.StatsFilter{
+		Sort:     strings.ToLower(sort),
+		TeamName: team,
+		GroupBy:  groupBy,
+		From:     from,
+		To:       to,
+	}
+
+	switch filter.Sort {
+	case "":
+		filter.Sort = "desc"
+	case "desc", "asc":
+	default:
+		return filter, "sort must be 'desc' or 'asc'. can be omitted: 'desc' by default"
+	}
+
+	switch filter.GroupBy {
+	case "":
+		filter.GroupBy = entity.StatsGroupByUser
+	case entity.StatsGroupByUser, entity.StatsGroupByTeam, entity.StatsGroupByDay, entity.StatsGroupByWeek:
+	default:
+		return filter, "group_by must be user, team, day or week"
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, "from must be before to"
+	}
+
+	return filter, ""
+}
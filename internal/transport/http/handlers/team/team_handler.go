@@ -2,22 +2,32 @@ package team
 
 import (
 	"context"
-	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 
 	"railgorail/avito/internal/lib/sl"
-	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/service/team"
+	"railgorail/avito/internal/transport/http/api"
 	"railgorail/avito/internal/transport/http/dto"
+	"railgorail/avito/internal/transport/http/handlers"
 
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
 )
 
 type teamService interface {
 	Add(ctx context.Context, teamName string, users []dto.TeamMember) (*dto.TeamSchema, error)
 	Get(ctx context.Context, teamName string) (*dto.TeamSchema, error)
+	Sync(ctx context.Context, roster *dto.Roster, opts team.SyncOptions) (*dto.SyncResponse, error)
+	Import(ctx context.Context, rows []dto.TeamRow) (*dto.ImportResponse, error)
+	Export(ctx context.Context, teamName string) ([]dto.TeamRow, error)
+	GetSizeTiers(ctx context.Context, teamName string) (*dto.SizeTiersResponse, error)
+	SetSizeTiers(ctx context.Context, teamName string, tiers []dto.SizeTier) (*dto.SizeTiersResponse, error)
+	GetRoutingRules(ctx context.Context, teamName string) (*dto.RoutingRulesResponse, error)
+	AddRoutingRule(ctx context.Context, teamName string, rule dto.RoutingRule) (*dto.RoutingRule, error)
+	UpdateRoutingRule(ctx context.Context, rule dto.RoutingRule) (*dto.RoutingRule, error)
+	DeleteRoutingRule(ctx context.Context, ruleID int) error
 }
 
 type TeamHandler struct {
@@ -32,13 +42,104 @@ func NewTeamHandler(log *slog.Logger, s teamService) *TeamHandler {
 	}
 }
 
-type TeamAddRequest struct {
-	TeamName string           `json:"team_name" validate:"required"`
-	Members  []dto.TeamMember `json:"members"   validate:"required,dive"`
+func (h *TeamHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.team.AddTeam"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	var input api.AddTeamJSONRequestBody
+
+	if err := render.DecodeJSON(r.Body, &input); err != nil {
+		log.Error("failed to decode request body", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
+		return
+	}
+
+	resp, err := h.service.Add(ctx, input.TeamName, teamMembers(input.Members))
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while saving team")
+		return
+	}
+
+	log.Info("team created successfully")
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, dto.TeamResponse{Team: *resp})
+}
+
+func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request, params api.GetTeamParams) {
+	const op = "handlers.team.GetTeam"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	teamName := params.TeamName
+	if teamName == "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "team_name is required"))
+		return
+	}
+
+	resp, err := h.service.Get(ctx, teamName)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while retrieving team")
+		return
+	}
+	log.Info("team retrieved")
+	render.JSON(w, r, resp)
+}
+
+// SyncTeams accepts a roster document in YAML or JSON.
+func (h *TeamHandler) SyncTeams(w http.ResponseWriter, r *http.Request, params api.SyncTeamsParams) {
+	const op = "handlers.team.SyncTeams"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	dryRun := handlers.Value(params.DryRun)
+	maxDeactivations := team.DefaultMaxDeactivations
+	if params.MaxDeactivations != nil {
+		maxDeactivations = *params.MaxDeactivations
+	}
+
+	handlers.LimitBody(w, r, handlers.MaxUploadBytes)
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		handlers.RenderBodyError(w, r, log, err)
+		return
+	}
+
+	roster, err := dto.ParseRoster(body)
+	if err != nil {
+		log.Error("failed to decode roster", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
+		return
+	}
+
+	resp, err := h.service.Sync(ctx, roster, team.SyncOptions{DryRun: dryRun, MaxDeactivations: maxDeactivations})
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while syncing teams")
+		return
+	}
+
+	log.Info("teams synced", slog.Bool("dry_run", dryRun), slog.Int("changes", len(resp.Changes)))
+	render.JSON(w, r, resp)
 }
 
-func (h *TeamHandler) Add(w http.ResponseWriter, r *http.Request) {
-	const op = "handlers.team.Add"
+// ImportTeams takes CSV or JSONL rows of team_name,user_id,username,is_active.
+// The format comes from the format query parameter or the Content-Type.
+func (h *TeamHandler) ImportTeams(w http.ResponseWriter, r *http.Request, params api.ImportTeamsParams) {
+	const op = "handlers.team.ImportTeams"
 	log := h.log.With(
 		slog.String("op", op),
 		slog.String("request_id", middleware.GetReqID(r.Context())),
@@ -46,53 +147,202 @@ func (h *TeamHandler) Add(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	var input TeamAddRequest
+	format := dto.FormatFromContentType(r.Header.Get("Content-Type"))
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
 
+	var (
+		rows    []dto.TeamRow
+		rowErrs []dto.RowError
+		err     error
+	)
+	handlers.LimitBody(w, r, handlers.MaxUploadBytes)
+	switch format {
+	case dto.FormatCSV:
+		rows, rowErrs, err = dto.ParseTeamRowsCSV(r.Body)
+	case dto.FormatJSONL:
+		rows, rowErrs, err = dto.ParseTeamRowsJSONL(r.Body)
+	default:
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "format must be 'csv' or 'jsonl'"))
+		return
+	}
+	if err != nil {
+		handlers.RenderBodyError(w, r, log, err)
+		return
+	}
+
+	if len(rowErrs) > 0 {
+		log.Info("invalid import rows", slog.Int("invalid", len(rowErrs)))
+
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, dto.ImportError(rowErrs))
+		return
+	}
+
+	if len(rows) == 0 {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "no rows to import"))
+		return
+	}
+
+	resp, err := h.service.Import(ctx, rows)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while importing teams")
+		return
+	}
+
+	log.Info("teams imported", slog.Int("rows", resp.Rows))
+	render.JSON(w, r, resp)
+}
+
+func (h *TeamHandler) ExportTeams(w http.ResponseWriter, r *http.Request, params api.ExportTeamsParams) {
+	const op = "handlers.team.ExportTeams"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	format := dto.FormatCSV
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
+	if format != dto.FormatCSV && format != dto.FormatJSONL {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "format must be 'csv' or 'jsonl'"))
+		return
+	}
+
+	rows, err := h.service.Export(ctx, handlers.Value(params.TeamName))
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while exporting teams")
+		return
+	}
+
+	if format == dto.FormatJSONL {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		err = dto.WriteTeamRowsJSONL(w, rows)
+	} else {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="teams.csv"`)
+		err = dto.WriteTeamRowsCSV(w, rows)
+	}
+	if err != nil {
+		log.Error("failed to write export", sl.Err(err))
+		return
+	}
+
+	log.Info("teams exported", slog.Int("rows", len(rows)))
+}
+
+func (h *TeamHandler) GetSizeTiers(w http.ResponseWriter, r *http.Request, params api.GetSizeTiersParams) {
+	const op = "handlers.team.GetSizeTiers"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	teamName := params.TeamName
+	if teamName == "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "team_name is required"))
+		return
+	}
+
+	resp, err := h.service.GetSizeTiers(ctx, teamName)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while retrieving size tiers")
+		return
+	}
+
+	render.JSON(w, r, resp)
+}
+
+func (h *TeamHandler) SetSizeTiers(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.team.SetSizeTiers"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	var input api.SetSizeTiersJSONRequestBody
 	if err := render.DecodeJSON(r.Body, &input); err != nil {
 		log.Error("failed to decode request body", sl.Err(err))
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.Error(dto.ErrBadRequest, "bad request"))
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
 		return
 	}
 
-	if err := validator.New().Struct(input); err != nil {
-		validateError := func() validator.ValidationErrors {
-			var target validator.ValidationErrors
-			_ = errors.As(err, &target)
-			return target
-		}()
+	resp, err := h.service.SetSizeTiers(ctx, input.TeamName, sizeTiers(handlers.Value(input.Tiers)))
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while saving size tiers")
+		return
+	}
 
-		log.Error("invalid request", sl.Err(err))
+	render.JSON(w, r, resp)
+}
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.ValidationError(validateError))
+func (h *TeamHandler) GetRoutingRules(w http.ResponseWriter, r *http.Request, params api.GetRoutingRulesParams) {
+	const op = "handlers.team.GetRoutingRules"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	teamName := params.TeamName
+	if teamName == "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "team_name is required"))
 		return
 	}
 
-	resp, err := h.service.Add(ctx, input.TeamName, input.Members)
+	resp, err := h.service.GetRoutingRules(ctx, teamName)
 	if err != nil {
-		if errors.Is(err, repo.ErrTeamExists) {
-			log.Error("team exists", sl.Err(err))
+		handlers.RenderError(w, r, log, err, "error while retrieving routing rules")
+		return
+	}
 
-			render.Status(r, http.StatusBadRequest)
-			render.JSON(w, r, dto.Error(dto.ErrCodeTeamExists, err.Error()))
-			return
-		}
-		log.Error("error while saving team", sl.Err(err))
+	render.JSON(w, r, resp)
+}
 
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, dto.InternalError())
+func (h *TeamHandler) AddRoutingRule(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.team.AddRoutingRule"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	var input api.AddRoutingRuleJSONRequestBody
+	if err := render.DecodeJSON(r.Body, &input); err != nil {
+		log.Error("failed to decode request body", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
+		return
+	}
+
+	resp, err := h.service.AddRoutingRule(ctx, input.TeamName, dto.RoutingRule{
+		Label:          input.Label,
+		Action:         string(input.Action),
+		TargetTeamName: handlers.Value(input.TargetTeamName),
+		Reviewers:      input.Reviewers,
+	})
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while saving routing rule")
 		return
 	}
 
-	log.Info("team created successfully")
 	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, dto.TeamResponse{Team: *resp})
+	render.JSON(w, r, resp)
 }
 
-func (h *TeamHandler) Get(w http.ResponseWriter, r *http.Request) {
-	const op = "handlers.team.Get"
+func (h *TeamHandler) UpdateRoutingRule(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.team.UpdateRoutingRule"
 	log := h.log.With(
 		slog.String("op", op),
 		slog.String("request_id", middleware.GetReqID(r.Context())),
@@ -100,26 +350,70 @@ func (h *TeamHandler) Get(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	teamName := r.URL.Query().Get("team_name")
-	if teamName == "" {
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.Error(dto.ErrBadRequest, "team_name is required"))
+	var input api.UpdateRoutingRuleJSONRequestBody
+	if err := render.DecodeJSON(r.Body, &input); err != nil {
+		log.Error("failed to decode request body", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
 		return
 	}
 
-	resp, err := h.service.Get(ctx, teamName)
+	resp, err := h.service.UpdateRoutingRule(ctx, dto.RoutingRule{
+		ID:             input.Id,
+		Label:          input.Label,
+		Action:         string(input.Action),
+		TargetTeamName: handlers.Value(input.TargetTeamName),
+		Reviewers:      input.Reviewers,
+	})
 	if err != nil {
-		if errors.Is(err, repo.ErrNotFound) {
-			log.Info("team not found", sl.Err(err))
-			render.Status(r, http.StatusNotFound)
-			render.JSON(w, r, dto.Error(dto.ErrCodeNotFound, err.Error()))
-			return
-		}
-		log.Error("error while retrieving team", sl.Err(err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, dto.InternalError())
+		handlers.RenderError(w, r, log, err, "error while saving routing rule")
 		return
 	}
-	log.Info("team retrieved")
+
 	render.JSON(w, r, resp)
 }
+
+func (h *TeamHandler) DeleteRoutingRule(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.team.DeleteRoutingRule"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	var input api.DeleteRoutingRuleJSONRequestBody
+	if err := render.DecodeJSON(r.Body, &input); err != nil {
+		log.Error("failed to decode request body", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
+		return
+	}
+
+	if err := h.service.DeleteRoutingRule(ctx, input.Id); err != nil {
+		handlers.RenderError(w, r, log, err, "error while saving routing rule")
+		return
+	}
+
+	render.JSON(w, r, input)
+}
+
+func teamMembers(members []api.TeamMember) []dto.TeamMember {
+	out := make([]dto.TeamMember, 0, len(members))
+	for _, m := range members {
+		out = append(out, dto.TeamMember{UserID: m.UserId, Username: m.Username, IsActive: m.IsActive})
+	}
+	return out
+}
+
+func sizeTiers(tiers []api.SizeTier) []dto.SizeTier {
+	out := make([]dto.SizeTier, 0, len(tiers))
+	for _, t := range tiers {
+		out = append(out, dto.SizeTier{
+			MaxLines:        t.MaxLines,
+			Reviewers:       t.Reviewers,
+			SeniorReviewers: handlers.Value(t.SeniorReviewers),
+		})
+	}
+	return out
+}
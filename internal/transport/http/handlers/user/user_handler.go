@@ -2,24 +2,34 @@ package user
 
 import (
 	"context"
-	"errors"
 	"log/slog"
 	"net/http"
+	"strings"
 
+	"railgorail/avito/internal/entity"
 	"railgorail/avito/internal/lib/sl"
-	"railgorail/avito/internal/repo"
+	"railgorail/avito/internal/transport/http/api"
 	"railgorail/avito/internal/transport/http/dto"
+	"railgorail/avito/internal/transport/http/handlers"
 
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
 )
 
 type userService interface {
-	GetReview(ctx context.Context, userID string) (*dto.GetReviewResponse, error)
+	GetReview(ctx context.Context, filter entity.ReviewFilter) (*dto.GetReviewResponse, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) (*dto.UserSchema, error)
+	Get(ctx context.Context, userID string) (*dto.UserSchema, error)
+	List(ctx context.Context, filter entity.UserFilter) (*dto.UserListResponse, error)
+	Update(ctx context.Context, userID, name string, isSenior *bool) (*dto.UserSchema, error)
+	Delete(ctx context.Context, userID string, reassign bool) (*dto.DeleteUserResponse, error)
 }
 
+const (
+	defaultListLimit = 50
+	maxListLimit     = 100
+)
+
 type UserHandler struct {
 	log     *slog.Logger
 	service userService
@@ -32,13 +42,8 @@ func NewUserHandler(log *slog.Logger, s userService) *UserHandler {
 	}
 }
 
-type SetIsActiveRequest struct {
-	UserID   string `json:"user_id"   validate:"required"`
-	IsActive bool   `json:"is_active"`
-}
-
-func (h *UserHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
-	const op = "handlers.user.SetIsActive"
+func (h *UserHandler) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.user.SetUserIsActive"
 	log := h.log.With(
 		slog.String("op", op),
 		slog.String("request_id", middleware.GetReqID(r.Context())),
@@ -46,46 +51,104 @@ func (h *UserHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	var input SetIsActiveRequest
+	var input api.SetUserIsActiveJSONRequestBody
 
 	if err := render.DecodeJSON(r.Body, &input); err != nil {
 		log.Error("failed to decode request body", sl.Err(err))
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.Error(dto.ErrBadRequest, "bad request"))
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
 		return
 	}
 
-	if err := validator.New().Struct(input); err != nil {
-		validateError := err.(validator.ValidationErrors)
+	resp, err := h.service.SetIsActive(ctx, input.UserId, input.IsActive)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while changing user")
+		return
+	}
 
-		log.Error("invalid request", sl.Err(err))
+	log.Info("user changed successfully")
+	render.JSON(w, r, dto.UserResponse{User: *resp})
+}
 
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.ValidationError(validateError))
+func (h *UserHandler) GetUserReviews(w http.ResponseWriter, r *http.Request, params api.GetUserReviewsParams) {
+	const op = "handlers.user.GetUserReviews"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	filter, msg := parseReviewFilter(params)
+	if msg != "" {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, msg))
 		return
 	}
 
-	resp, err := h.service.SetIsActive(ctx, input.UserID, input.IsActive)
+	resp, err := h.service.GetReview(ctx, filter)
 	if err != nil {
-		if errors.Is(err, repo.ErrNotFound) {
-			log.Info("user not found", sl.Err(err))
-			render.Status(r, http.StatusNotFound)
-			render.JSON(w, r, dto.Error(dto.ErrCodeNotFound, err.Error()))
-			return
-		}
-		log.Error("error while changing user", sl.Err(err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, dto.InternalError())
+		handlers.RenderError(w, r, log, err, "error while retrieving prs")
 		return
 	}
 
-	log.Info("user changed successfully")
-	render.JSON(w, r, dto.UserResponse{User: *resp})
+	log.Info("retrieved prs successfully")
+	render.JSON(w, r, resp)
+}
+
+// parseReviewFilter reads user_id, status (comma separated, OPEN by default),
+// order (asc by assigned_at by default), limit and cursor.
+func parseReviewFilter(params api.GetUserReviewsParams) (entity.ReviewFilter, string) {
+	filter := entity.ReviewFilter{
+		UserID:   params.UserId,
+		Statuses: []string{"OPEN"},
+		Limit:    defaultListLimit,
+	}
+	if filter.UserID == "" {
+		return filter, "user_id is required"
+	}
+
+	if v := handlers.Value(params.Status); v != "" {
+		filter.Statuses = nil
+		for _, status := range strings.Split(v, ",") {
+			status = strings.ToUpper(strings.TrimSpace(status))
+			if status != "OPEN" && status != "MERGED" {
+				return filter, "status must be OPEN, MERGED or both separated by a comma"
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	switch handlers.Value(params.Order) {
+	case "", api.GetUserReviewsParamsOrderAsc:
+	case api.GetUserReviewsParamsOrderDesc:
+		filter.Desc = true
+	default:
+		return filter, "order must be asc or desc"
+	}
+
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > maxListLimit {
+			return filter, "limit must be between 1 and 100"
+		}
+		filter.Limit = *params.Limit
+	}
+
+	if v := handlers.Value(params.Cursor); v != "" {
+		cursor, err := dto.DecodeCursor(v)
+		if err != nil {
+			return filter, err.Error()
+		}
+		if cursor.Sort != "assigned_at" || cursor.Desc != filter.Desc || cursor.Value == "infinity" {
+			return filter, "cursor was issued for a different order"
+		}
+		filter.After = &entity.PullRequestCursor{Value: cursor.Value, ID: cursor.ID}
+	}
+
+	return filter, ""
 }
 
-func (h *UserHandler) GetReview(w http.ResponseWriter, r *http.Request) {
-	const op = "handlers.user.GetReview"
+func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request, params api.GetUserParams) {
+	const op = "handlers.user.GetUser"
 	log := h.log.With(
 		slog.String("op", op),
 		slog.String("request_id", middleware.GetReqID(r.Context())),
@@ -93,27 +156,121 @@ func (h *UserHandler) GetReview(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	userID := r.URL.Query().Get("user_id")
+	userID := params.UserId
 	if userID == "" {
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, dto.Error(dto.ErrBadRequest, "user_id is required"))
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "user_id is required"))
 		return
 	}
 
-	resp, err := h.service.GetReview(ctx, userID)
+	resp, err := h.service.Get(ctx, userID)
 	if err != nil {
-		if errors.Is(err, repo.ErrNotFound) {
-			log.Info("prs not found", sl.Err(err))
-			render.Status(r, http.StatusNotFound)
-			render.JSON(w, r, dto.Error(dto.ErrCodeNotFound, err.Error()))
+		handlers.RenderError(w, r, log, err, "error while retrieving user")
+		return
+	}
+
+	log.Info("user retrieved")
+	render.JSON(w, r, dto.UserResponse{User: *resp})
+}
+
+func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request, params api.ListUsersParams) {
+	const op = "handlers.user.ListUsers"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	filter := entity.UserFilter{
+		TeamName: handlers.Value(params.TeamName),
+		IsActive: params.IsActive,
+		Limit:    defaultListLimit,
+	}
+
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > maxListLimit {
+			dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "limit must be between 1 and 100"))
 			return
 		}
-		log.Error("error while retrieving prs", sl.Err(err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, dto.InternalError())
+		filter.Limit = *params.Limit
+	}
+
+	if params.Offset != nil {
+		if *params.Offset < 0 {
+			dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "offset must be a non-negative integer"))
+			return
+		}
+		filter.Offset = *params.Offset
+	}
+
+	resp, err := h.service.List(ctx, filter)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while listing users")
 		return
 	}
 
-	log.Info("retrieved prs successfully")
+	log.Info("users listed")
+	render.JSON(w, r, resp)
+}
+
+func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.user.UpdateUser"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	var input api.UpdateUserJSONRequestBody
+
+	if err := render.DecodeJSON(r.Body, &input); err != nil {
+		log.Error("failed to decode request body", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
+		return
+	}
+
+	// the spec cannot tie username to the absence of is_senior
+	if handlers.Value(input.Username) == "" && input.IsSenior == nil {
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrValidationErr, "field 'username' is required without 'is_senior'"))
+		return
+	}
+
+	resp, err := h.service.Update(ctx, input.UserId, handlers.Value(input.Username), input.IsSenior)
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while updating user")
+		return
+	}
+
+	log.Info("user updated successfully")
+	render.JSON(w, r, dto.UserResponse{User: *resp})
+}
+
+func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.user.DeleteUser"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+
+	ctx := r.Context()
+
+	var input api.DeleteUserJSONRequestBody
+
+	if err := render.DecodeJSON(r.Body, &input); err != nil {
+		log.Error("failed to decode request body", sl.Err(err))
+
+		dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "bad request"))
+		return
+	}
+
+	resp, err := h.service.Delete(ctx, input.UserId, handlers.Value(input.ReassignReviews))
+	if err != nil {
+		handlers.RenderError(w, r, log, err, "error while deleting user")
+		return
+	}
+
+	log.Info("user deleted successfully")
 	render.JSON(w, r, resp)
 }
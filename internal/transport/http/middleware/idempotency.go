@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/transport/http/dto"
+	"railgorail/avito/internal/transport/http/handlers"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+type IdempotencyStore interface {
+	Reserve(ctx context.Context, key, requestHash string, ttl time.Duration) (*entity.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, key string, statusCode int, contentType string, body []byte) error
+	Release(ctx context.Context, key string) error
+}
+
+// Idempotency replays the stored response for a mutating request that repeats
+// an Idempotency-Key. The key is bound to the method, path, query, content type
+// and body of the first request, reusing it for another request is rejected with 422. Server
+// errors are not stored, the request runs again on retry.
+func Idempotency(log *slog.Logger, store IdempotencyStore, ttl time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		log := log.With(
+			slog.String("component", "middleware/idempotency"),
+		)
+
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || !isMutating(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			entry := log.With(
+				slog.String("request_id", middleware.GetReqID(r.Context())),
+				slog.String("idempotency_key", key),
+			)
+
+			if len(key) > maxIdempotencyKeyLength {
+				dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrBadRequest, "Idempotency-Key is too long"))
+				return
+			}
+
+			handlers.LimitBody(w, r, handlers.MaxUploadBytes)
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				handlers.RenderBodyError(w, r, entry, err)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			hash := requestHash(r, body)
+
+			record, created, err := store.Reserve(r.Context(), key, hash, ttl)
+			if err != nil {
+				entry.Error("failed to reserve idempotency key", sl.Err(err))
+				dto.WriteError(w, r, http.StatusInternalServerError, dto.InternalError())
+				return
+			}
+
+			if !created {
+				replay(w, r, record, hash)
+				return
+			}
+
+			var buf bytes.Buffer
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&buf)
+
+			// the response is stored even if the client goes away, a panic
+			// leaves the status at 0 and frees the key
+			ctx := context.WithoutCancel(r.Context())
+			defer func() {
+				if ww.Status() == 0 || ww.Status() >= http.StatusInternalServerError {
+					if err := store.Release(ctx, key); err != nil {
+						entry.Error("failed to release idempotency key", sl.Err(err))
+					}
+					return
+				}
+				if err := store.Complete(ctx, key, ww.Status(), ww.Header().Get("Content-Type"), buf.Bytes()); err != nil {
+					entry.Error("failed to store idempotent response", sl.Err(err))
+				}
+			}()
+
+			next.ServeHTTP(ww, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+func replay(w http.ResponseWriter, r *http.Request, record *entity.IdempotencyRecord, hash string) {
+	switch {
+	case record.RequestHash != hash:
+		dto.WriteError(w, r, http.StatusUnprocessableEntity, dto.Error(dto.ErrCodeIdempotencyKeyReused, "Idempotency-Key was used for a different request"))
+
+	case record.StatusCode == nil:
+		dto.WriteError(w, r, http.StatusConflict, dto.Error(dto.ErrCodeRequestInProgress, "request with this Idempotency-Key is in progress"))
+
+	default:
+		if record.ContentType != "" {
+			w.Header().Set("Content-Type", record.ContentType)
+		}
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(*record.StatusCode)
+		_, _ = w.Write(record.ResponseBody)
+	}
+}
+
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.RawQuery))
+	h.Write([]byte{0})
+	h.Write([]byte(r.Header.Get("Content-Type")))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
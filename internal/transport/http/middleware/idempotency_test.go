@@ -0,0 +1,236 @@
+package middleware_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"railgorail/avito/internal/entity"
+	"railgorail/avito/internal/transport/http/dto"
+	"railgorail/avito/internal/transport/http/handlers"
+	"railgorail/avito/internal/transport/http/middleware"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var discardLog = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// fakeStore keeps the keys in memory, ttl is ignored.
+type fakeStore struct {
+	mu      sync.Mutex
+	records map[string]*entity.IdempotencyRecord
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{records: make(map[string]*entity.IdempotencyRecord)}
+}
+
+func (s *fakeStore) Reserve(_ context.Context, key, requestHash string, _ time.Duration) (*entity.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		stored := *record
+		return &stored, false, nil
+	}
+	s.records[key] = &entity.IdempotencyRecord{Key: key, RequestHash: requestHash}
+	return nil, true, nil
+}
+
+func (s *fakeStore) Complete(_ context.Context, key string, statusCode int, contentType string, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := s.records[key]
+	record.StatusCode = &statusCode
+	record.ContentType = contentType
+	record.ResponseBody = body
+	return nil
+}
+
+func (s *fakeStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok && record.StatusCode == nil {
+		delete(s.records, key)
+	}
+	return nil
+}
+
+func (s *fakeStore) has(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.records[key]
+	return ok
+}
+
+func send(h http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", strings.NewReader(body))
+	req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotency_ReplaysStoredResponse(t *testing.T) {
+	calls := 0
+	h := middleware.Idempotency(discardLog, newFakeStore(), time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"pr":{"pull_request_id":"pr-1"}}`))
+	}))
+
+	first := send(h, "key-1", `{"pull_request_id":"pr-1"}`)
+	second := send(h, "key-1", `{"pull_request_id":"pr-1"}`)
+
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, first.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, "application/json", second.Header().Get("Content-Type"))
+	assert.Equal(t, "true", second.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Equal(t, first.Body.String(), second.Body.String())
+}
+
+func TestIdempotency_Rejects(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    func(h *http.Handler) http.HandlerFunc
+		body       string // sent after the first request, if set
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name: "different body under the same key",
+			handler: func(*http.Handler) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusCreated)
+				}
+			},
+			body:       `{"pull_request_id":"pr-2"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   dto.ErrCodeIdempotencyKeyReused,
+		},
+		{
+			// the retry arrives while the first request is still running
+			name: "request in flight",
+			handler: func(h *http.Handler) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					retry := send(*h, "key-1", `{"pull_request_id":"pr-1"}`)
+					w.WriteHeader(retry.Code)
+					_, _ = w.Write(retry.Body.Bytes())
+				}
+			},
+			wantStatus: http.StatusConflict,
+			wantCode:   dto.ErrCodeRequestInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var h http.Handler
+			h = middleware.Idempotency(discardLog, newFakeStore(), time.Hour)(tt.handler(&h))
+
+			rec := send(h, "key-1", `{"pull_request_id":"pr-1"}`)
+			if tt.body != "" {
+				rec = send(h, "key-1", tt.body)
+			}
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantCode)
+			assert.Empty(t, rec.Header().Get(middleware.IdempotentReplayedHeader))
+		})
+	}
+}
+
+func TestIdempotency_ServerErrorReleasesKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{
+			name: "5xx",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+		},
+		{
+			name: "panic",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				panic("boom")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeStore()
+			h := middleware.Idempotency(discardLog, store, time.Hour)(tt.handler)
+
+			func() {
+				defer func() { _ = recover() }()
+				send(h, "key-1", `{"pull_request_id":"pr-1"}`)
+			}()
+
+			assert.False(t, store.has("key-1"), "the key should be free for a retry")
+		})
+	}
+}
+
+// /team/sync?dry_run=true must not be replayed for the real sync, nor a CSV
+// import for the same bytes sent as JSONL
+func TestIdempotency_KeyIsBoundToQueryAndContentType(t *testing.T) {
+	tests := []struct {
+		name                  string
+		firstTarget, target   string
+		firstType, secondType string
+	}{
+		{name: "query", firstTarget: "/team/sync?dry_run=true", target: "/team/sync", firstType: "application/json", secondType: "application/json"},
+		{name: "content type", firstTarget: "/team/import", target: "/team/import", firstType: "text/csv", secondType: "application/x-ndjson"},
+	}
+
+	post := func(h http.Handler, target, contentType string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(`{"teams":[]}`))
+		req.Header.Set(middleware.IdempotencyKeyHeader, "key-1")
+		req.Header.Set("Content-Type", contentType)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			h := middleware.Idempotency(discardLog, newFakeStore(), time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			post(h, tt.firstTarget, tt.firstType)
+			rec := post(h, tt.target, tt.secondType)
+
+			assert.Equal(t, 1, calls)
+			assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+			assert.Contains(t, rec.Body.String(), dto.ErrCodeIdempotencyKeyReused)
+		})
+	}
+}
+
+func TestIdempotency_BodyTooLarge(t *testing.T) {
+	h := middleware.Idempotency(discardLog, newFakeStore(), time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("the handler must not run")
+	}))
+
+	rec := send(h, "key-1", strings.Repeat("a", handlers.MaxUploadBytes+1))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Contains(t, rec.Body.String(), dto.ErrCodeRequestTooLarge)
+}
@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"railgorail/avito/internal/lib"
+	"railgorail/avito/internal/lib/sl"
+	"railgorail/avito/internal/transport/http/dto"
+	"railgorail/avito/internal/transport/http/handlers"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// OpenAPI checks requests against spec before they reach the handlers and
+// answers mismatches with 400 VALIDATION_ERROR. Paths the spec does not know
+// are passed through, the router answers them. With validateResponses the
+// responses are checked too, mismatches are only logged.
+func OpenAPI(log *slog.Logger, spec *openapi3.T, validateResponses bool) (func(next http.Handler) http.Handler, error) {
+	const op = "middleware.OpenAPI"
+
+	specRouter, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next http.Handler) http.Handler {
+		log := log.With(
+			slog.String("component", "middleware/openapi"),
+		)
+
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := specRouter.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// clients have always been able to omit the JSON content type
+			if r.ContentLength != 0 && r.Header.Get("Content-Type") == "" {
+				r.Header.Set("Content-Type", "application/json")
+			}
+
+			// the body is read whole for validation, nothing the API takes
+			// is bigger than an upload
+			handlers.LimitBody(w, r, handlers.MaxUploadBytes)
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					handlers.RenderBodyError(w, r, log, err)
+					return
+				}
+
+				msg := validationMessage(err)
+				log.Debug("request does not match the spec",
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.String("error", msg),
+				)
+				dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrValidationErr, msg))
+				return
+			}
+
+			if !validateResponses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var buf bytes.Buffer
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&buf)
+
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			err = openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: input,
+				Status:                 status,
+				Header:                 ww.Header(),
+				Body:                   io.NopCloser(&buf),
+				Options:                options,
+			})
+			if err != nil {
+				log.Warn("response does not match the spec",
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.String("route", r.Method+" "+route.Path),
+					slog.Int("status", status),
+					sl.Err(err),
+				)
+			}
+		}
+		return http.HandlerFunc(fn)
+	}, nil
+}
+
+// validationMessage turns kin-openapi errors into the "field 'x' ..." lines
+// the handlers use, without echoing the schema back to the client.
+func validationMessage(err error) string {
+	var msgs []string
+	collectMessages(err, "", &msgs)
+	if len(msgs) == 0 {
+		return "request does not match the API spec"
+	}
+	return strings.Join(msgs, ", ")
+}
+
+func collectMessages(err error, param string, msgs *[]string) {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, inner := range e {
+			collectMessages(inner, param, msgs)
+		}
+
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			param = fmt.Sprintf("%s parameter '%s'", e.Parameter.In, e.Parameter.Name)
+		}
+		switch {
+		case e.Err == nil:
+			*msgs = append(*msgs, prefixed(param, e.Reason))
+		case errors.Is(e.Err, openapi3filter.ErrInvalidRequired) && param != "":
+			*msgs = append(*msgs, param+" is required")
+		case errors.Is(e.Err, openapi3filter.ErrInvalidRequired):
+			*msgs = append(*msgs, "request body is required")
+		default:
+			collectMessages(e.Err, param, msgs)
+		}
+
+	case *openapi3.SchemaError:
+		if origin, ok := e.Origin.(openapi3.MultiError); ok {
+			collectMessages(origin, param, msgs)
+			return
+		}
+		if path := e.JSONPointer(); len(path) > 0 && param == "" {
+			param = fmt.Sprintf("field '%s'", strings.Join(path, "."))
+		}
+		reason := e.Reason
+		switch e.SchemaField {
+		case "required":
+			if param != "" {
+				*msgs = append(*msgs, param+" is required")
+				return
+			}
+		case "pattern":
+			reason = "has an invalid value"
+		case "format":
+			reason = fmt.Sprintf("must be a %s string", e.Schema.Format)
+		}
+		*msgs = append(*msgs, prefixed(param, reason))
+
+	case *openapi3filter.ParseError:
+		if param == "" {
+			param = "request body"
+		}
+		*msgs = append(*msgs, param+" cannot be parsed")
+
+	default:
+		*msgs = append(*msgs, prefixed(param, err.Error()))
+	}
+}
+
+func prefixed(param, reason string) string {
+	if param == "" {
+		return reason
+	}
+	return param + ": " + reason
+}
@@ -2,56 +2,85 @@ package router
 
 import (
 	"log/slog"
+	"net/http"
+
 	"railgorail/avito/internal/config"
-	"railgorail/avito/internal/transport/http/handlers"
+	"railgorail/avito/internal/lib"
+	"railgorail/avito/internal/metrics"
+	"railgorail/avito/internal/transport/http/api"
+	"railgorail/avito/internal/transport/http/dto"
+	"railgorail/avito/internal/transport/http/handlers/health"
 	"railgorail/avito/internal/transport/http/handlers/pr"
 	"railgorail/avito/internal/transport/http/handlers/stats"
 	"railgorail/avito/internal/transport/http/handlers/team"
 	"railgorail/avito/internal/transport/http/handlers/user"
 	mw "railgorail/avito/internal/transport/http/middleware"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 )
 
-func New(log *slog.Logger, cfg *config.Config,
+func New(log *slog.Logger, cfg *config.Config, spec *openapi3.T,
 	teamHandler *team.TeamHandler,
 	userHandler *user.UserHandler,
 	prHandler *pr.PrHandler,
 	statsHandler *stats.StatsHandler,
-) chi.Router {
+	healthHandler *health.HealthHandler,
+	idempotencyStore mw.IdempotencyStore,
+) (chi.Router, error) {
+	const op = "router.New"
+
+	openAPI, err := mw.OpenAPI(log, spec, cfg.HTTPServer.ValidateResponses)
+	if err != nil {
+		return nil, lib.Err(op, err)
+	}
+
 	router := chi.NewRouter()
 
 	router.Use(middleware.RequestID)
+	router.Use(mw.Tracing)
 	router.Use(mw.New(log))
+	router.Use(mw.Metrics)
 	router.Use(middleware.Recoverer)
 	router.Use(middleware.URLFormat)
+	router.Use(openAPI)
+	router.Use(mw.Idempotency(log, idempotencyStore, cfg.HTTPServer.IdempotencyTTL))
 	log.Info("starting http server", slog.String("address", cfg.HTTPServer.Address))
 
-	// Health check
-	router.Get("/health", handlers.Healthcheck())
-
-	// Team routes
-	router.Route("/team", func(r chi.Router) {
-		r.Post("/add", teamHandler.Add)
-		r.Get("/get", teamHandler.Get)
+	// Routes come from docs/openapi.yml, the handlers together implement
+	// the generated api.ServerInterface
+	api.HandlerWithOptions(server{
+		TeamHandler:   teamHandler,
+		UserHandler:   userHandler,
+		PrHandler:     prHandler,
+		StatsHandler:  statsHandler,
+		HealthHandler: healthHandler,
+		metrics:       metrics.Handler(),
+	}, api.ChiServerOptions{
+		BaseRouter: router,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			dto.WriteError(w, r, http.StatusBadRequest, dto.Error(dto.ErrValidationErr, err.Error()))
+		},
 	})
 
-	// User routes
-	router.Route("/users", func(r chi.Router) {
-		r.Get("/getReview", userHandler.GetReview)
-		r.Post("/setIsActive", userHandler.SetIsActive)
-	})
+	return router, nil
+}
 
-	// Pull Request routes
-	router.Route("/pullRequest", func(r chi.Router) {
-		r.Post("/create", prHandler.Create)
-		r.Post("/merge", prHandler.Merge)
-		r.Post("/reassign", prHandler.Reassign)
-	})
+var _ api.ServerInterface = server{}
 
-	// Stats routes
-	router.Get("/stats", statsHandler.GetStatistics)
+// server joins the handlers into one api.ServerInterface.
+type server struct {
+	*team.TeamHandler
+	*user.UserHandler
+	*pr.PrHandler
+	*stats.StatsHandler
+	*health.HealthHandler
+
+	metrics http.Handler
+}
 
-	return router
+// GetMetrics serves Prometheus metrics.
+func (s server) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	s.metrics.ServeHTTP(w, r)
 }
@@ -0,0 +1,48 @@
+package router_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"railgorail/avito/docs"
+	"railgorail/avito/internal/config"
+	"railgorail/avito/internal/transport/http/router"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Every route has to be described in docs/openapi.yml, otherwise it is
+// served without validation and the published contract is incomplete.
+func TestRoutesMatchSpec(t *testing.T) {
+	spec, err := docs.LoadOpenAPI(context.Background())
+	require.NoError(t, err)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	r, err := router.New(log, &config.Config{}, spec, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	routes := make(map[string]bool)
+	err = chi.Walk(r, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		route = strings.TrimSuffix(route, "/")
+		routes[method+" "+route] = true
+
+		item := spec.Paths.Find(route)
+		if assert.NotNil(t, item, "%s %s is not in docs/openapi.yml", method, route) {
+			assert.NotNil(t, item.GetOperation(method), "%s %s is not in docs/openapi.yml", method, route)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	for path, item := range spec.Paths.Map() {
+		for method := range item.Operations() {
+			require.True(t, routes[method+" "+path], "%s %s is in docs/openapi.yml but not routed", method, path)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS users_track_absence ON users;
+
+DROP FUNCTION IF EXISTS track_user_absence();
+
+DROP TABLE IF EXISTS user_absences;
@@ -0,0 +1,31 @@
+-- periods a user was inactive, fairness stats leave them out of active days.
+-- kept by a trigger so every path that writes is_active records them
+CREATE TABLE user_absences (
+    id BIGSERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ended_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE UNIQUE INDEX idx_user_absences_open ON user_absences (user_id) WHERE ended_at IS NULL;
+CREATE INDEX idx_user_absences_user_id ON user_absences (user_id, started_at);
+
+CREATE FUNCTION track_user_absence() RETURNS TRIGGER AS $$
+BEGIN
+    IF NOT NEW.is_active AND (TG_OP = 'INSERT' OR OLD.is_active) THEN
+        INSERT INTO user_absences (user_id) VALUES (NEW.id);
+    ELSIF NEW.is_active AND TG_OP = 'UPDATE' AND NOT OLD.is_active THEN
+        UPDATE user_absences SET ended_at = CURRENT_TIMESTAMP
+        WHERE user_id = NEW.id AND ended_at IS NULL;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_track_absence
+    AFTER INSERT OR UPDATE OF is_active ON users
+    FOR EACH ROW EXECUTE FUNCTION track_user_absence();
+
+-- users inactive right now have been away since an unknown moment
+INSERT INTO user_absences (user_id, started_at)
+SELECT id, created_at FROM users WHERE NOT is_active;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS stats_daily_prs;
+
+DROP TABLE IF EXISTS stats_daily_assignments;
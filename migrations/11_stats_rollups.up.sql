@@ -0,0 +1,35 @@
+-- daily rollups read by /stats, kept by the repo in the transactions that
+-- change reviewers and PRs. statsrebuild refills them from the source tables.
+
+-- current reviewers by the day they were assigned and the team of the PR.
+-- user_id is not a foreign key, the rows of a deleted user still count for the team
+CREATE TABLE stats_daily_assignments (
+    day DATE NOT NULL,
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    assignment_count INTEGER NOT NULL DEFAULT 0,
+    weighted_load INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, team_id, user_id)
+);
+
+CREATE INDEX idx_stats_daily_assignments_user_id ON stats_daily_assignments (user_id, day);
+
+-- PRs by the day they were created, merged_count is how many of them are merged
+CREATE TABLE stats_daily_prs (
+    day DATE NOT NULL,
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    created_count INTEGER NOT NULL DEFAULT 0,
+    merged_count INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, team_id)
+);
+
+INSERT INTO stats_daily_assignments (day, team_id, user_id, assignment_count, weighted_load)
+SELECT pr.assigned_at::date, p.team_id, pr.user_id, COUNT(*), SUM(GREATEST(1, CEIL(p.size / 100.0)))::int
+FROM pr_reviewers pr
+JOIN pull_requests p ON p.id = pr.pull_request_id
+GROUP BY 1, 2, 3;
+
+INSERT INTO stats_daily_prs (day, team_id, created_count, merged_count)
+SELECT p.created_at::date, p.team_id, COUNT(*), COUNT(*) FILTER (WHERE p.status = 'MERGED')
+FROM pull_requests p
+GROUP BY 1, 2;
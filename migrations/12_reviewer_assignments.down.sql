@@ -0,0 +1,6 @@
+ALTER TABLE stats_daily_assignments
+    DROP COLUMN completed_count,
+    DROP COLUMN reassigned_count,
+    DROP COLUMN assigned_count;
+
+DROP TABLE IF EXISTS reviewer_assignments;
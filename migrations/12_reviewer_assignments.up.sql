@@ -0,0 +1,40 @@
+-- every reviewer assignment, pr_reviewers only holds the current ones.
+-- user_id is not a foreign key, deleting a user keeps their history
+-- (closed with reason user_deleted)
+CREATE TABLE reviewer_assignments (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    assigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    unassigned_at TIMESTAMP DEFAULT NULL,
+    reason TEXT DEFAULT NULL,
+    CHECK ((unassigned_at IS NULL) = (reason IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_reviewer_assignments_current
+    ON reviewer_assignments (pull_request_id, user_id) WHERE unassigned_at IS NULL;
+CREATE INDEX idx_reviewer_assignments_user_id ON reviewer_assignments (user_id, assigned_at);
+
+-- reviews reassigned before this migration are gone
+INSERT INTO reviewer_assignments (pull_request_id, user_id, assigned_at)
+SELECT pull_request_id, user_id, assigned_at FROM pr_reviewers;
+
+-- assignment_count and weighted_load stay the current reviews, the rest counts
+-- every assignment by how it ended
+ALTER TABLE stats_daily_assignments
+    ADD COLUMN assigned_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN reassigned_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN completed_count INTEGER NOT NULL DEFAULT 0;
+
+UPDATE stats_daily_assignments SET assigned_count = assignment_count;
+
+UPDATE stats_daily_assignments d
+SET completed_count = c.completed
+FROM (
+    SELECT pr.assigned_at::date AS day, p.team_id, pr.user_id, COUNT(*) AS completed
+    FROM pr_reviewers pr
+    JOIN pull_requests p ON p.id = pr.pull_request_id
+    WHERE p.status = 'MERGED'
+    GROUP BY 1, 2, 3
+) c
+WHERE d.day = c.day AND d.team_id = c.team_id AND d.user_id = c.user_id;
@@ -0,0 +1,30 @@
+-- the old schema keeps one team per user, refuse to guess which one to drop
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM team_members GROUP BY user_id HAVING COUNT(*) > 1
+    ) THEN
+        RAISE EXCEPTION 'users belonging to several teams cannot be rolled back to a single team_id';
+    END IF;
+
+    IF EXISTS (
+        SELECT 1 FROM users u
+        WHERE NOT EXISTS (SELECT 1 FROM team_members m WHERE m.user_id = u.id)
+    ) THEN
+        RAISE EXCEPTION 'users without a team cannot be rolled back to a single team_id';
+    END IF;
+END $$;
+
+ALTER TABLE users DROP COLUMN team_id;
+ALTER TABLE users ADD COLUMN team_id INTEGER REFERENCES teams(id) ON DELETE RESTRICT;
+
+UPDATE users u
+SET team_id = m.team_id
+FROM team_members m
+WHERE m.user_id = u.id;
+
+ALTER TABLE users ALTER COLUMN team_id SET NOT NULL;
+
+ALTER TABLE pull_requests DROP COLUMN team_id;
+
+DROP TABLE IF EXISTS team_members;
@@ -0,0 +1,25 @@
+CREATE TABLE team_members (
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX team_members_user_id_idx ON team_members (user_id);
+
+INSERT INTO team_members (team_id, user_id, joined_at)
+SELECT team_id, id, created_at FROM users;
+
+
+-- home team of the PR, candidates for review are taken from it
+ALTER TABLE pull_requests ADD COLUMN team_id INTEGER REFERENCES teams(id) ON DELETE RESTRICT;
+
+UPDATE pull_requests p
+SET team_id = u.team_id
+FROM users u
+WHERE u.id = p.author_id;
+
+ALTER TABLE pull_requests ALTER COLUMN team_id SET NOT NULL;
+
+
+ALTER TABLE users DROP COLUMN team_id;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_pull_request_id;
+DROP INDEX IF EXISTS idx_pull_requests_team_id;
+DROP INDEX IF EXISTS idx_pull_requests_author_id;
+DROP INDEX IF EXISTS idx_pull_requests_merged_at;
+DROP INDEX IF EXISTS idx_pull_requests_created_at;
@@ -0,0 +1,5 @@
+CREATE INDEX idx_pull_requests_created_at ON pull_requests (created_at, id);
+CREATE INDEX idx_pull_requests_merged_at ON pull_requests (merged_at, id);
+CREATE INDEX idx_pull_requests_author_id ON pull_requests (author_id);
+CREATE INDEX idx_pull_requests_team_id ON pull_requests (team_id);
+CREATE INDEX idx_pr_reviewers_pull_request_id ON pr_reviewers (pull_request_id);
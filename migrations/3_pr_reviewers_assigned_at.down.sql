@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_user_assigned_at;
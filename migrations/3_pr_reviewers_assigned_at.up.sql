@@ -0,0 +1 @@
+CREATE INDEX idx_pr_reviewers_user_assigned_at ON pr_reviewers (user_id, assigned_at, pull_request_id);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS pr_labels;
+
+ALTER TABLE pull_requests
+    DROP COLUMN size,
+    DROP COLUMN url,
+    DROP COLUMN description;
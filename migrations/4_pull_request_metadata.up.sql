@@ -0,0 +1,12 @@
+ALTER TABLE pull_requests
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN size INTEGER NOT NULL DEFAULT 0 CHECK (size >= 0);
+
+CREATE TABLE pr_labels (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    label TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, label)
+);
+
+CREATE INDEX idx_pr_labels_label ON pr_labels (label);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS team_size_tiers;
+
+ALTER TABLE users DROP COLUMN is_senior;
+
+ALTER TABLE pull_requests
+    DROP COLUMN files_changed,
+    DROP COLUMN deletions,
+    DROP COLUMN additions;
@@ -0,0 +1,16 @@
+ALTER TABLE pull_requests
+    ADD COLUMN additions INTEGER CHECK (additions >= 0),
+    ADD COLUMN deletions INTEGER CHECK (deletions >= 0),
+    ADD COLUMN files_changed INTEGER CHECK (files_changed >= 0);
+
+ALTER TABLE users ADD COLUMN is_senior BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- a PR of a team goes to the first tier with max_lines >= its size,
+-- max_lines NULL matches any size
+CREATE TABLE team_size_tiers (
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    max_lines INTEGER CHECK (max_lines > 0),
+    reviewers INTEGER NOT NULL CHECK (reviewers > 0),
+    senior_reviewers INTEGER NOT NULL DEFAULT 0 CHECK (senior_reviewers >= 0 AND senior_reviewers <= reviewers),
+    UNIQUE (team_id, max_lines)
+);
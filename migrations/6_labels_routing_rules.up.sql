@@ -0,0 +1,18 @@
+-- add_team_member adds a reviewer from target_team_id,
+-- set_reviewers overrides the number of reviewers from the team's own members
+CREATE TABLE routing_rules (
+    id SERIAL PRIMARY KEY,
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    label TEXT NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('add_team_member', 'set_reviewers')),
+    target_team_id INTEGER REFERENCES teams(id) ON DELETE CASCADE,
+    reviewers INTEGER CHECK (reviewers > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (
+        (action = 'add_team_member' AND target_team_id IS NOT NULL AND reviewers IS NULL) OR
+        (action = 'set_reviewers' AND target_team_id IS NULL AND reviewers IS NOT NULL)
+    )
+);
+
+CREATE UNIQUE INDEX idx_routing_rules_unique
+    ON routing_rules (team_id, label, action, COALESCE(target_team_id, 0));
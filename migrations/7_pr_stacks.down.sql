@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pull_requests_parent_id;
+
+ALTER TABLE pull_requests DROP COLUMN parent_id;
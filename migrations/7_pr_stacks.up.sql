@@ -0,0 +1,4 @@
+ALTER TABLE pull_requests
+    ADD COLUMN parent_id TEXT REFERENCES pull_requests(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_pull_requests_parent_id ON pull_requests (parent_id) WHERE parent_id IS NOT NULL;
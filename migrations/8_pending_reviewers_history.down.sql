@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS pr_events;
+
+DROP INDEX IF EXISTS idx_pull_requests_pending;
+
+ALTER TABLE pull_requests DROP COLUMN pending_reviewers;
@@ -0,0 +1,16 @@
+-- seats Create could not fill, the reconciler fills them as candidates appear
+ALTER TABLE pull_requests
+    ADD COLUMN pending_reviewers INTEGER NOT NULL DEFAULT 0 CHECK (pending_reviewers >= 0);
+
+CREATE INDEX idx_pull_requests_pending ON pull_requests (team_id) WHERE pending_reviewers > 0 AND status = 'OPEN';
+
+CREATE TABLE pr_events (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    user_id TEXT,
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_pr_events_pull_request_id ON pr_events (pull_request_id, id);
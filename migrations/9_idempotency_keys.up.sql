@@ -0,0 +1,13 @@
+-- responses of mutating requests sent with an Idempotency-Key header,
+-- status_code is NULL while the first request is still running
+CREATE TABLE idempotency_keys (
+    key TEXT PRIMARY KEY,
+    request_hash TEXT NOT NULL,
+    status_code INTEGER,
+    content_type TEXT NOT NULL DEFAULT '',
+    response_body BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- expired keys are purged by created_at
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys (created_at);
@@ -0,0 +1,8 @@
+// Package client is a typed Go client for the reviewer service, generated
+// from docs/openapi.yml.
+//
+//	c, err := client.NewClientWithResponses("http://reviewer:8080")
+//	resp, err := c.CreatePullRequestWithResponse(ctx, nil, client.CreatePullRequestJSONRequestBody{...})
+package client
+
+//go:generate go tool oapi-codegen --config=oapi-codegen.yml ../../docs/openapi.yml
@@ -1,25 +1,32 @@
 package e2e
 
 import (
-	"bytes"
-	"encoding/json"
-	"io/ioutil"
+	"context"
 	"log"
 	"net/http"
 	"os"
 	"testing"
 	"time"
+
+	"railgorail/avito/pkg/client"
 )
 
 var base_url = "http://test-app:8080"
 
+var api *client.ClientWithResponses
+
 func TestMain(m *testing.M) {
+	var err error
+	api, err = client.NewClientWithResponses(base_url)
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+
 	waitForService()
 	os.Exit(m.Run())
 }
 
 func waitForService() {
-	healthURL := base_url + "/health"
 	maxWait := 5 * time.Minute
 	checkInterval := 2 * time.Second
 
@@ -29,17 +36,13 @@ func waitForService() {
 			log.Fatalf("Service did not become ready in %v", maxWait)
 		}
 
-		resp, err := http.Get(healthURL)
-		if err == nil && resp.StatusCode == http.StatusOK {
+		resp, err := api.GetReadinessWithResponse(context.Background())
+		if err == nil && resp.StatusCode() == http.StatusOK {
 			log.Println("Service is ready!")
-			resp.Body.Close()
 			return
 		}
-		if resp != nil {
-			resp.Body.Close()
-		}
 
-		log.Printf("Waiting for service to be ready at %s...", healthURL)
+		log.Printf("Waiting for service to be ready at %s...", base_url)
 		time.Sleep(checkInterval)
 	}
 }
@@ -52,6 +55,56 @@ func (t *E2ETest) unique(name string) string {
 	return name + "-" + time.Now().Format("20060102150405.999999")
 }
 
+func mustSend(subT *testing.T, err error) {
+	subT.Helper()
+	if err != nil {
+		subT.Fatalf("Failed to send request: %v", err)
+	}
+}
+
+func expectStatus(subT *testing.T, status int, body []byte, want int) {
+	subT.Helper()
+	if status != want {
+		subT.Fatalf("Expected status %d, got %d. Body: %s", want, status, body)
+	}
+}
+
+func member(userID, username string) client.TeamMember {
+	return client.TeamMember{UserId: userID, Username: username, IsActive: true}
+}
+
+func addTeam(subT *testing.T, teamName string, members ...client.TeamMember) {
+	subT.Helper()
+	resp, err := api.AddTeamWithResponse(context.Background(), client.AddTeamJSONRequestBody{
+		TeamName: teamName,
+		Members:  members,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusCreated)
+}
+
+func createPR(subT *testing.T, prID, name, authorID string) client.PullRequest {
+	subT.Helper()
+	resp, err := api.CreatePullRequestWithResponse(context.Background(), nil, client.CreatePullRequestJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: name,
+		AuthorId:        authorID,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusCreated)
+	return resp.JSON201.Pr
+}
+
+func mergePR(subT *testing.T, prID string) client.PullRequest {
+	subT.Helper()
+	resp, err := api.MergePullRequestWithResponse(context.Background(), client.MergePullRequestJSONRequestBody{
+		PullRequestId: prID,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusOK)
+	return resp.JSON200.Pr
+}
+
 func TestE2E(t *testing.T) {
 	tt := &E2ETest{t}
 	t.Run("TeamHappyPath", tt.TestTeamHappyPath)
@@ -69,722 +122,274 @@ func TestE2E(t *testing.T) {
 	t.Run("ReassignOnNonExistingPR", tt.TestReassignOnNonExistingPR)
 	t.Run("ReassignNotAssignedReviewer", tt.TestReassignNotAssignedReviewer)
 	t.Run("ReassignOnMergedPR", tt.TestReassignOnMergedPR)
+	t.Run("InvalidRequest", tt.TestInvalidRequest)
 }
 
 func (t *E2ETest) TestTeamHappyPath(subT *testing.T) {
-	// Team data
+	ctx := context.Background()
 	teamName := t.unique("backend")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": t.unique("u1"), "username": "Alice", "is_active": true},
-			{"user_id": t.unique("u2"), "username": "Bob", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
 
 	// 1. Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
+	addTeam(subT, teamName,
+		member(t.unique("u1"), "Alice"),
+		member(t.unique("u2"), "Bob"),
+	)
 
 	// 2. Get team
-	resp, err = http.Get(base_url + "/team/get?team_name=" + teamName)
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
+	resp, err := api.GetTeamWithResponse(ctx, &client.GetTeamParams{TeamName: teamName})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusOK)
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 200, got %d. Body: %s", resp.StatusCode, body)
+	if resp.JSON200.TeamName != teamName {
+		subT.Errorf("Expected team_name '%s', got '%s'", teamName, resp.JSON200.TeamName)
 	}
-
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		subT.Fatalf("Failed to decode response: %v", err)
-	}
-
-	if result["team_name"] != teamName {
-		subT.Errorf("Expected team_name '%s', got '%s'", teamName, result["team_name"])
+	if len(resp.JSON200.Members) != 2 {
+		subT.Errorf("Expected 2 members, got %d", len(resp.JSON200.Members))
 	}
 }
 
 func (t *E2ETest) TestCreatePR(subT *testing.T) {
-	// Team data
 	teamName := t.unique("create-pr-team")
 	authorID := t.unique("u3")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": authorID, "username": "User3", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
-
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	addTeam(subT, teamName, member(authorID, "User3"))
 
-	// PR data
 	prID := t.unique("pr")
-	pr := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Fix critical bug",
-		"author_id":         authorID,
-	}
-	prJSON, _ := json.Marshal(pr)
-
-	// Create PR
-	resp, err = http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
+	pr := createPR(subT, prID, "Fix critical bug", authorID)
 
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
+	if pr.PullRequestId != prID {
+		subT.Errorf("Expected pr id '%s', got '%s'", prID, pr.PullRequestId)
 	}
-
-	var result map[string]map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		subT.Fatalf("Failed to decode response: %v", err)
-	}
-
-	if result["pr"]["pull_request_id"] != prID {
-		subT.Errorf("Expected pr id '%s', got '%s'", prID, result["pr"]["pull_request_id"])
+	if pr.Status != client.PullRequestStatusOPEN {
+		subT.Errorf("Expected pr status 'OPEN', got '%s'", pr.Status)
 	}
 }
 
 func (t *E2ETest) TestMergePR(subT *testing.T) {
-	// Team data
 	teamName := t.unique("merge-pr-team")
 	authorID := t.unique("u4")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": authorID, "username": "User4", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
-
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	addTeam(subT, teamName, member(authorID, "User4"))
 
-	// PR data
 	prID := t.unique("pr-merge")
-	pr := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Merge test",
-		"author_id":         authorID,
-	}
-	prJSON, _ := json.Marshal(pr)
-
-	// Create PR
-	resp, err = http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
-
-	// Merge PR
-	merge := map[string]interface{}{
-		"pull_request_id": prID,
-	}
-	mergeJSON, _ := json.Marshal(merge)
-	resp, err = http.Post(base_url+"/pullRequest/merge", "application/json", bytes.NewBuffer(mergeJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
+	createPR(subT, prID, "Merge test", authorID)
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 200, got %d. Body: %s", resp.StatusCode, body)
-	}
-
-	var result map[string]map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		subT.Fatalf("Failed to decode response: %v", err)
-	}
-
-	if result["pr"]["status"] != "MERGED" {
-		subT.Errorf("Expected pr status 'MERGED', got '%s'", result["pr"]["status"])
+	pr := mergePR(subT, prID)
+	if pr.Status != client.PullRequestStatusMERGED {
+		subT.Errorf("Expected pr status 'MERGED', got '%s'", pr.Status)
 	}
 }
 
 func (t *E2ETest) TestReassignPR(subT *testing.T) {
-	// Team data
 	teamName := t.unique("reassign-pr-team")
 	authorID := t.unique("u10")
-	user11ID := t.unique("u11")
-	user12ID := t.unique("u12")
-	user13ID := t.unique("u13")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": authorID, "username": "User10", "is_active": true},
-			{"user_id": user11ID, "username": "User11", "is_active": true},
-			{"user_id": user12ID, "username": "User12", "is_active": true},
-			{"user_id": user13ID, "username": "User13", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
+	addTeam(subT, teamName,
+		member(authorID, "User10"),
+		member(t.unique("u11"), "User11"),
+		member(t.unique("u12"), "User12"),
+		member(t.unique("u13"), "User13"),
+	)
 
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
-
-	// PR data
 	prID := t.unique("pr-reassign")
-	pr := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Reassign test",
-		"author_id":         authorID,
-	}
-	prJSON, _ := json.Marshal(pr)
-
-	// Create PR
-	resp, err = http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-
-	var createResp map[string]map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&createResp); err != nil {
-		subT.Fatalf("Failed to decode response: %v", err)
-	}
-	resp.Body.Close()
-
-	reviewers := createResp["pr"]["assigned_reviewers"].([]interface{})
-	if len(reviewers) == 0 {
+	pr := createPR(subT, prID, "Reassign test", authorID)
+	if len(pr.AssignedReviewers) == 0 {
 		subT.Fatalf("No reviewers assigned, cannot test reassign")
 	}
-	oldReviewer := reviewers[0].(string)
+	oldReviewer := pr.AssignedReviewers[0]
 
-	// Reassign PR
-	reassign := map[string]interface{}{
-		"pull_request_id": prID,
-		"old_reviewer_id": oldReviewer,
-	}
-	reassignJSON, _ := json.Marshal(reassign)
+	resp, err := api.ReassignPullRequestWithResponse(context.Background(), nil, client.ReassignPullRequestJSONRequestBody{
+		PullRequestId: prID,
+		OldReviewerId: oldReviewer,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusOK)
 
-	resp, err = http.Post(base_url+"/pullRequest/reassign", "application/json", bytes.NewBuffer(reassignJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 200, got %d. Body: %s", resp.StatusCode, body)
-	}
-
-	var reassignResp map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&reassignResp); err != nil {
-		subT.Fatalf("Failed to decode response: %v", err)
-	}
-
-	newReviewer := reassignResp["replaced_by"].(string)
-	if newReviewer == oldReviewer {
+	if resp.JSON200.ReplacedBy == oldReviewer {
 		subT.Errorf("Expected a new reviewer, but got the same one")
 	}
 }
 
 func (t *E2ETest) TestSetUserActiveStatus(subT *testing.T) {
-	// Team data
 	teamName := t.unique("status-test-team")
 	userID := t.unique("u20")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": userID, "username": "User20", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
-
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
-
-	// Set user status
-	status := map[string]interface{}{
-		"user_id":   userID,
-		"is_active": false,
-	}
-	statusJSON, _ := json.Marshal(status)
-
-	resp, err = http.Post(base_url+"/users/setIsActive", "application/json", bytes.NewBuffer(statusJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 200, got %d. Body: %s", resp.StatusCode, body)
-	}
+	addTeam(subT, teamName, member(userID, "User20"))
 
-	var result map[string]map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		subT.Fatalf("Failed to decode response: %v", err)
-	}
+	resp, err := api.SetUserIsActiveWithResponse(context.Background(), client.SetUserIsActiveJSONRequestBody{
+		UserId:   userID,
+		IsActive: false,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusOK)
 
-	if result["user"]["is_active"] != false {
-		subT.Errorf("Expected is_active to be false, got %v", result["user"]["is_active"])
+	if resp.JSON200.User.IsActive {
+		subT.Errorf("Expected is_active to be false, got %v", resp.JSON200.User.IsActive)
 	}
 }
 
 func (t *E2ETest) TestGetUserReviews(subT *testing.T) {
-	// Team data
 	teamName := t.unique("review-test-team")
 	authorID := t.unique("u30")
 	reviewerID := t.unique("u31")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": authorID, "username": "User30", "is_active": true},
-			{"user_id": reviewerID, "username": "User31", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
-
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	addTeam(subT, teamName, member(authorID, "User30"), member(reviewerID, "User31"))
 
-	// PR data
 	prID := t.unique("pr-review")
-	pr := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Review test",
-		"author_id":         authorID,
-	}
-	prJSON, _ := json.Marshal(pr)
+	createPR(subT, prID, "Review test", authorID)
 
-	// Create PR
-	resp, err = http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	resp, err := api.GetUserReviewsWithResponse(context.Background(), &client.GetUserReviewsParams{UserId: reviewerID})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusOK)
 
-	// Get user reviews
-	resp, err = http.Get(base_url + "/users/getReview?user_id=" + reviewerID)
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
+	if resp.JSON200.UserId != reviewerID {
+		subT.Errorf("Expected user_id '%s', got '%s'", reviewerID, resp.JSON200.UserId)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 200, got %d. Body: %s", resp.StatusCode, body)
-	}
-
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		subT.Fatalf("Failed to decode response: %v", err)
-	}
-
-	if result["user_id"] != reviewerID {
-		subT.Errorf("Expected user_id '%s', got '%s'", reviewerID, result["user_id"])
-	}
-
-	prs := result["pull_requests"].([]interface{})
+	prs := resp.JSON200.PullRequests
 	if len(prs) != 1 {
-		subT.Errorf("Expected 1 PR, got %d", len(prs))
+		subT.Fatalf("Expected 1 PR, got %d", len(prs))
 	}
-
-	if prs[0].(map[string]interface{})["pull_request_id"] != prID {
-		subT.Errorf("Expected pr id '%s', got '%s'", prID, prs[0].(map[string]interface{})["pull_request_id"])
+	if prs[0].PullRequestId != prID {
+		subT.Errorf("Expected pr id '%s', got '%s'", prID, prs[0].PullRequestId)
 	}
 }
 
 func (t *E2ETest) TestAddExistingTeam(subT *testing.T) {
-	// Team data
 	teamName := t.unique("existing-team")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": t.unique("u40"), "username": "User40", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
-
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	userID := t.unique("u40")
+	addTeam(subT, teamName, member(userID, "User40"))
 
 	// Add the same team again
-	resp, err = http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
+	resp, err := api.AddTeamWithResponse(context.Background(), client.AddTeamJSONRequestBody{
+		TeamName: teamName,
+		Members:  []client.TeamMember{member(userID, "User40")},
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusBadRequest)
 
-	if resp.StatusCode != http.StatusBadRequest {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 400, got %d. Body: %s", resp.StatusCode, body)
+	if resp.JSON400.Error.Code != client.TEAMEXISTS {
+		subT.Errorf("Expected code TEAM_EXISTS, got %s", resp.JSON400.Error.Code)
 	}
 }
 
 func (t *E2ETest) TestGetNonExistingTeam(subT *testing.T) {
-	resp, err := http.Get(base_url + "/team/get?team_name=" + t.unique("non-existing-team"))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNotFound {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 404, got %d. Body: %s", resp.StatusCode, body)
-	}
+	resp, err := api.GetTeamWithResponse(context.Background(), &client.GetTeamParams{
+		TeamName: t.unique("non-existing-team"),
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusNotFound)
 }
 
 func (t *E2ETest) TestSetStatusOfNonExistingUser(subT *testing.T) {
-	status := map[string]interface{}{
-		"user_id":   t.unique("non-existing-user"),
-		"is_active": false,
-	}
-	statusJSON, _ := json.Marshal(status)
-
-	resp, err := http.Post(base_url+"/users/setIsActive", "application/json", bytes.NewBuffer(statusJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNotFound {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 404, got %d. Body: %s", resp.StatusCode, body)
-	}
+	resp, err := api.SetUserIsActiveWithResponse(context.Background(), client.SetUserIsActiveJSONRequestBody{
+		UserId:   t.unique("non-existing-user"),
+		IsActive: false,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusNotFound)
 }
 
 func (t *E2ETest) TestCreatePRWithNonExistingAuthor(subT *testing.T) {
-	pr := map[string]interface{}{
-		"pull_request_id":   t.unique("pr-non-existing-author"),
-		"pull_request_name": "Test PR",
-		"author_id":         t.unique("non-existing-user"),
-	}
-	prJSON, _ := json.Marshal(pr)
-
-	resp, err := http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNotFound {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 404, got %d. Body: %s", resp.StatusCode, body)
-	}
+	resp, err := api.CreatePullRequestWithResponse(context.Background(), nil, client.CreatePullRequestJSONRequestBody{
+		PullRequestId:   t.unique("pr-non-existing-author"),
+		PullRequestName: "Test PR",
+		AuthorId:        t.unique("non-existing-user"),
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusNotFound)
 }
 
 func (t *E2ETest) TestCreateExistingPR(subT *testing.T) {
-	// Team data
 	teamName := t.unique("existing-pr-team")
 	authorID := t.unique("u50")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": authorID, "username": "User50", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
+	addTeam(subT, teamName, member(authorID, "User50"))
 
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
-
-	// PR data
 	prID := t.unique("pr-existing")
-	pr := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Test PR",
-		"author_id":         authorID,
-	}
-	prJSON, _ := json.Marshal(pr)
-
-	// Create PR
-	resp, err = http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	createPR(subT, prID, "Test PR", authorID)
 
 	// Create the same PR again
-	resp, err = http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
+	resp, err := api.CreatePullRequestWithResponse(context.Background(), nil, client.CreatePullRequestJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Test PR",
+		AuthorId:        authorID,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusConflict)
 
-	if resp.StatusCode != http.StatusConflict {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 409, got %d. Body: %s", resp.StatusCode, body)
+	if resp.JSON409.Error.Code != client.PREXISTS {
+		subT.Errorf("Expected code PR_EXISTS, got %s", resp.JSON409.Error.Code)
 	}
 }
 
 func (t *E2ETest) TestMergeNonExistingPR(subT *testing.T) {
-	pr := map[string]interface{}{
-		"pull_request_id": t.unique("pr-non-existing"),
-	}
-	prJSON, _ := json.Marshal(pr)
-
-	resp, err := http.Post(base_url+"/pullRequest/merge", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNotFound {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 404, got %d. Body: %s", resp.StatusCode, body)
-	}
+	resp, err := api.MergePullRequestWithResponse(context.Background(), client.MergePullRequestJSONRequestBody{
+		PullRequestId: t.unique("pr-non-existing"),
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusNotFound)
 }
 
 func (t *E2ETest) TestReassignOnNonExistingPR(subT *testing.T) {
-	reassign := map[string]interface{}{
-		"pull_request_id": t.unique("pr-non-existing"),
-		"old_reviewer_id": t.unique("u1"),
-	}
-	reassignJSON, _ := json.Marshal(reassign)
-
-	resp, err := http.Post(base_url+"/pullRequest/reassign", "application/json", bytes.NewBuffer(reassignJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNotFound {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 404, got %d. Body: %s", resp.StatusCode, body)
-	}
+	resp, err := api.ReassignPullRequestWithResponse(context.Background(), nil, client.ReassignPullRequestJSONRequestBody{
+		PullRequestId: t.unique("pr-non-existing"),
+		OldReviewerId: t.unique("u1"),
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusNotFound)
 }
 
 func (t *E2ETest) TestReassignNotAssignedReviewer(subT *testing.T) {
-	// Team data
 	teamName := t.unique("reassign-not-assigned-team")
 	authorID := t.unique("u60")
 	user61ID := t.unique("u61")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": authorID, "username": "User60", "is_active": true},
-			{"user_id": user61ID, "username": "User61", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
+	addTeam(subT, teamName, member(authorID, "User60"), member(user61ID, "User61"))
 
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
-
-	// PR data
 	prID := t.unique("pr-reassign-not-assigned")
-	pr := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Test PR",
-		"author_id":         authorID,
-	}
-	prJSON, _ := json.Marshal(pr)
-
-	// Create PR
-	resp, err = http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	createPR(subT, prID, "Test PR", authorID)
 
 	// Reassign a user who is not a reviewer
-	reassign := map[string]interface{}{
-		"pull_request_id": prID,
-		"old_reviewer_id": user61ID,
-	}
-	reassignJSON, _ := json.Marshal(reassign)
-
-	resp, err = http.Post(base_url+"/pullRequest/reassign", "application/json", bytes.NewBuffer(reassignJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusConflict {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 409, got %d. Body: %s", resp.StatusCode, body)
-	}
+	resp, err := api.ReassignPullRequestWithResponse(context.Background(), nil, client.ReassignPullRequestJSONRequestBody{
+		PullRequestId: prID,
+		OldReviewerId: user61ID,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusConflict)
 }
 
 func (t *E2ETest) TestReassignOnMergedPR(subT *testing.T) {
-	// Team data
 	teamName := t.unique("reassign-merged-pr-team")
 	authorID := t.unique("u70")
-	user71ID := t.unique("u71")
-	team := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": authorID, "username": "User70", "is_active": true},
-			{"user_id": user71ID, "username": "User71", "is_active": true},
-		},
-	}
-	teamJSON, _ := json.Marshal(team)
-
-	// Add team
-	resp, err := http.Post(base_url+"/team/add", "application/json", bytes.NewBuffer(teamJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	addTeam(subT, teamName, member(authorID, "User70"), member(t.unique("u71"), "User71"))
 
-	// PR data
 	prID := t.unique("pr-reassign-merged")
-	pr := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Test PR",
-		"author_id":         authorID,
-	}
-	prJSON, _ := json.Marshal(pr)
-
-	// Create PR
-	resp, err = http.Post(base_url+"/pullRequest/create", "application/json", bytes.NewBuffer(prJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 201, got %d. Body: %s", resp.StatusCode, body)
-	}
-	var createResp map[string]map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&createResp); err != nil {
-		subT.Fatalf("Failed to decode response: %v", err)
-	}
-	resp.Body.Close()
-
-	reviewers := createResp["pr"]["assigned_reviewers"].([]interface{})
-	if len(reviewers) == 0 {
+	pr := createPR(subT, prID, "Test PR", authorID)
+	if len(pr.AssignedReviewers) == 0 {
 		subT.Fatalf("No reviewers assigned, cannot test reassign")
 	}
-	oldReviewer := reviewers[0].(string)
+	oldReviewer := pr.AssignedReviewers[0]
 
-	// Merge PR
-	merge := map[string]interface{}{
-		"pull_request_id": prID,
-	}
-	mergeJSON, _ := json.Marshal(merge)
-	resp, err = http.Post(base_url+"/pullRequest/merge", "application/json", bytes.NewBuffer(mergeJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
-	}
-	if resp.StatusCode != http.StatusOK {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 200, got %d. Body: %s", resp.StatusCode, body)
-	}
-	resp.Body.Close()
+	mergePR(subT, prID)
 
 	// Reassign a reviewer on the merged PR
-	reassign := map[string]interface{}{
-		"pull_request_id": prID,
-		"old_reviewer_id": oldReviewer,
-	}
-	reassignJSON, _ := json.Marshal(reassign)
+	resp, err := api.ReassignPullRequestWithResponse(context.Background(), nil, client.ReassignPullRequestJSONRequestBody{
+		PullRequestId: prID,
+		OldReviewerId: oldReviewer,
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusConflict)
 
-	resp, err = http.Post(base_url+"/pullRequest/reassign", "application/json", bytes.NewBuffer(reassignJSON))
-	if err != nil {
-		subT.Fatalf("Failed to send request: %v", err)
+	if resp.JSON409.Error.Code != client.PRMERGED {
+		subT.Errorf("Expected code PR_MERGED, got %s", resp.JSON409.Error.Code)
 	}
-	defer resp.Body.Close()
+}
 
-	if resp.StatusCode != http.StatusConflict {
-		body, _ := ioutil.ReadAll(resp.Body)
-		subT.Fatalf("Expected status 409, got %d. Body: %s", resp.StatusCode, body)
+func (t *E2ETest) TestInvalidRequest(subT *testing.T) {
+	// the name is shorter than the spec allows
+	resp, err := api.CreatePullRequestWithResponse(context.Background(), nil, client.CreatePullRequestJSONRequestBody{
+		PullRequestId:   t.unique("pr-invalid"),
+		PullRequestName: "Fix",
+		AuthorId:        t.unique("u80"),
+	})
+	mustSend(subT, err)
+	expectStatus(subT, resp.StatusCode(), resp.Body, http.StatusBadRequest)
+
+	if resp.JSON400.Error.Code != client.VALIDATIONERROR {
+		subT.Errorf("Expected code VALIDATION_ERROR, got %s", resp.JSON400.Error.Code)
 	}
 }